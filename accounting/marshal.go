@@ -1,9 +1,8 @@
 package accounting
 
 import (
-	accounting "github.com/cthulhu-rider/neofs-api-go/v2/accounting/grpc"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
 	protoutil "github.com/cthulhu-rider/neofs-api-go/v2/util/proto"
-	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -55,15 +54,27 @@ func (d *Decimal) StableSize() (size int) {
 	return size
 }
 
-func (d *Decimal) Unmarshal(data []byte) error {
-	m := new(accounting.Decimal)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
+func (d *Decimal) StableUnmarshal(data []byte) error {
+	if d == nil {
+		return nil
 	}
 
-	*d = *DecimalFromGRPCMessage(m)
+	*d = Decimal{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case decimalValueField:
+			d.val, err = f.Int64()
+		case decimalPrecisionField:
+			d.prec, err = f.UInt32()
+		}
+
+		return
+	})
+}
 
-	return nil
+func (d *Decimal) Unmarshal(data []byte) error {
+	return d.StableUnmarshal(data)
 }
 
 func (b *BalanceRequestBody) StableMarshal(buf []byte) ([]byte, error) {
@@ -93,6 +104,24 @@ func (b *BalanceRequestBody) StableSize() (size int) {
 	return size
 }
 
+func (b *BalanceRequestBody) StableUnmarshal(data []byte) error {
+	if b == nil {
+		return nil
+	}
+
+	*b = BalanceRequestBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) error {
+		switch f.Num {
+		case balanceReqBodyOwnerField:
+			b.ownerID = new(refs.OwnerID)
+			return f.Nested(b.ownerID)
+		}
+
+		return nil
+	})
+}
+
 func (br *BalanceResponseBody) StableMarshal(buf []byte) ([]byte, error) {
 	if br == nil {
 		return []byte{}, nil
@@ -119,3 +148,21 @@ func (br *BalanceResponseBody) StableSize() (size int) {
 
 	return size
 }
+
+func (br *BalanceResponseBody) StableUnmarshal(data []byte) error {
+	if br == nil {
+		return nil
+	}
+
+	*br = BalanceResponseBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) error {
+		switch f.Num {
+		case balanceRespBodyDecimalField:
+			br.bal = new(Decimal)
+			return f.Nested(br.bal)
+		}
+
+		return nil
+	})
+}
@@ -23,6 +23,10 @@ func TestBalanceRequestBody_StableMarshal(t *testing.T) {
 
 		requestBodyTo := accounting.BalanceRequestBodyFromGRPCMessage(transport)
 		require.Equal(t, requestBodyFrom, requestBodyTo)
+
+		requestBodyTo = new(accounting.BalanceRequestBody)
+		require.NoError(t, requestBodyTo.StableUnmarshal(wire))
+		require.Equal(t, requestBodyFrom, requestBodyTo)
 	})
 }
 
@@ -39,6 +43,10 @@ func TestBalanceResponseBody_StableMarshal(t *testing.T) {
 
 		responseBodyTo := accounting.BalanceResponseBodyFromGRPCMessage(transport)
 		require.Equal(t, responseBodyFrom, responseBodyTo)
+
+		responseBodyTo = new(accounting.BalanceResponseBody)
+		require.NoError(t, responseBodyTo.StableUnmarshal(wire))
+		require.Equal(t, responseBodyFrom, responseBodyTo)
 	})
 }
 
@@ -78,4 +86,8 @@ func TestDecimalMarshal(t *testing.T) {
 	require.NoError(t, d2.Unmarshal(data))
 
 	require.Equal(t, d, d2)
+
+	d2 = new(accounting.Decimal)
+	require.NoError(t, d2.StableUnmarshal(data))
+	require.Equal(t, d, d2)
 }
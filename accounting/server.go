@@ -0,0 +1,35 @@
+package accounting
+
+import (
+	"context"
+
+	accounting "github.com/cthulhu-rider/neofs-api-go/v2/accounting/grpc"
+	"google.golang.org/grpc"
+)
+
+type grpcServer struct {
+	svc Service
+}
+
+// NewGRPCServer returns gRPC server of the accounting service
+// which handles requests with svc.
+func NewGRPCServer(svc Service) accounting.AccountingServiceServer {
+	return &grpcServer{
+		svc: svc,
+	}
+}
+
+// RegisterGRPCServer registers gRPC server of the accounting service
+// which handles requests with svc on gs.
+func RegisterGRPCServer(gs *grpc.Server, svc Service) {
+	accounting.RegisterAccountingServiceServer(gs, NewGRPCServer(svc))
+}
+
+func (s *grpcServer) Balance(ctx context.Context, req *accounting.BalanceRequest) (*accounting.BalanceResponse, error) {
+	resp, err := s.svc.Balance(ctx, BalanceRequestFromGRPCMessage(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return BalanceResponseToGRPCMessage(resp), nil
+}
@@ -0,0 +1,68 @@
+package accounting_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/accounting"
+	"github.com/cthulhu-rider/neofs-api-go/v2/client"
+	"github.com/cthulhu-rider/neofs-api-go/v2/internal/grpctest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testService struct {
+	err error
+
+	body *accounting.BalanceRequestBody
+}
+
+func (s *testService) Balance(_ context.Context, req *accounting.BalanceRequest) (*accounting.BalanceResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	s.body = req.GetBody()
+
+	resp := new(accounting.BalanceResponse)
+	resp.SetBody(generateBalanceResponseBody(int64(len(s.body.GetOwnerID().GetValue()))))
+
+	return resp, nil
+}
+
+func TestGRPCServer(t *testing.T) {
+	svc := new(testService)
+
+	conn := grpctest.Dial(t, func(gs *grpc.Server) {
+		accounting.RegisterGRPCServer(gs, svc)
+	})
+
+	c, err := accounting.NewClient(accounting.WithGlobalOpts(client.WithGRPCConn(conn)))
+	require.NoError(t, err)
+
+	req := new(accounting.BalanceRequest)
+	req.SetBody(generateBalanceRequestBody("owner"))
+
+	t.Run("balance", func(t *testing.T) {
+		resp, err := c.Balance(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, req.GetBody(), svc.body)
+		require.Equal(t, generateBalanceResponseBody(int64(len("owner"))), resp.GetBody())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		svc.err = status.Error(codes.NotFound, "unknown owner")
+		defer func() { svc.err = nil }()
+
+		_, err := c.Balance(context.Background(), req)
+		require.Error(t, err)
+
+		st, ok := status.FromError(errors.Cause(err))
+		require.True(t, ok)
+		require.Equal(t, codes.NotFound, st.Code())
+		require.Equal(t, "unknown owner", st.Message())
+	})
+}
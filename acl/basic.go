@@ -0,0 +1,353 @@
+package acl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// BasicACL is a basic access control list of the container.
+//
+// BasicACL is a 32-bit mask. Bits are numbered from the least
+// significant one:
+//   - bits 31 and 30 are reserved;
+//   - bit 29 is a sticky bit (X) which allows only the object owner
+//     to remove and overwrite the object;
+//   - bit 28 is a final bit (F) which prohibits extended ACL;
+//   - bits 27-0 are split into 4-bit sections per operation, from
+//     OperationRangeHash in bits 27-24 down to OperationGet in bits 3-0.
+//
+// Bits of the operation section from the most significant one allow
+// the operation to RoleUser (U), RoleSystem (S), RoleOthers (O) and
+// allow bearer token rules for the operation (B).
+type BasicACL uint32
+
+const (
+	// BasicACLPrivate allows container owner to perform all operations,
+	// system nodes are allowed to perform the operations required
+	// for the replication. Extended ACL is prohibited.
+	BasicACLPrivate BasicACL = 0x1C8C8CCC
+
+	// BasicACLPublicRead extends BasicACLPrivate with read operations
+	// allowed to all roles.
+	BasicACLPublicRead BasicACL = 0x1FBF8CFF
+
+	// BasicACLPublicReadWrite allows all operations to all roles.
+	BasicACLPublicReadWrite BasicACL = 0x1FBFBFFF
+
+	// BasicACLPublicAppend extends BasicACLPublicRead with put operation
+	// allowed to all roles.
+	BasicACLPublicAppend BasicACL = 0x1FBF9FFF
+
+	// BasicACLEACLPrivate is a BasicACLPrivate with extended ACL allowed.
+	BasicACLEACLPrivate BasicACL = 0x0C8C8CCC
+
+	// BasicACLEACLPublicRead is a BasicACLPublicRead with extended
+	// ACL allowed.
+	BasicACLEACLPublicRead BasicACL = 0x0FBF8CFF
+
+	// BasicACLEACLPublicReadWrite is a BasicACLPublicReadWrite with
+	// extended ACL allowed.
+	BasicACLEACLPublicReadWrite BasicACL = 0x0FBFBFFF
+
+	// BasicACLEACLPublicAppend is a BasicACLPublicAppend with extended
+	// ACL allowed.
+	BasicACLEACLPublicAppend BasicACL = 0x0FBF9FFF
+)
+
+const (
+	basicACLStickyBit = 29
+	basicACLFinalBit  = 28
+
+	basicACLBitsPerOp = 4
+
+	// bit offsets in the operation section
+	basicACLBearerBit = 0
+	basicACLOthersBit = 1
+	basicACLSystemBit = 2
+	basicACLUserBit   = 3
+)
+
+var basicACLPresets = []struct {
+	name string
+	val  BasicACL
+}{
+	{name: "private", val: BasicACLPrivate},
+	{name: "public-read", val: BasicACLPublicRead},
+	{name: "public-read-write", val: BasicACLPublicReadWrite},
+	{name: "public-append", val: BasicACLPublicAppend},
+	{name: "eacl-private", val: BasicACLEACLPrivate},
+	{name: "eacl-public-read", val: BasicACLEACLPublicRead},
+	{name: "eacl-public-read-write", val: BasicACLEACLPublicReadWrite},
+	{name: "eacl-public-append", val: BasicACLEACLPublicAppend},
+}
+
+// operations in the order of human-readable form
+var basicACLOperations = []struct {
+	name string
+	op   Operation
+}{
+	{name: "GET", op: OperationGet},
+	{name: "HEAD", op: OperationHead},
+	{name: "PUT", op: OperationPut},
+	{name: "DELETE", op: OperationDelete},
+	{name: "SEARCH", op: OperationSearch},
+	{name: "RANGE", op: OperationRange},
+	{name: "RANGEHASH", op: OperationRangeHash},
+}
+
+// letters of the operation section bits from the most significant one
+const basicACLOpLetters = "USOB"
+
+func basicACLOpBit(op Operation, bit int) (BasicACL, bool) {
+	if op < OperationGet || op > OperationRangeHash {
+		return 0, false
+	}
+
+	return 1 << (int(op-OperationGet)*basicACLBitsPerOp + bit), true
+}
+
+func basicACLRoleBit(role Role) (int, bool) {
+	switch role {
+	case RoleUser:
+		return basicACLUserBit, true
+	case RoleSystem:
+		return basicACLSystemBit, true
+	case RoleOthers:
+		return basicACLOthersBit, true
+	default:
+		return 0, false
+	}
+}
+
+func (a BasicACL) isSet(mask BasicACL) bool {
+	return a&mask == mask
+}
+
+func (a *BasicACL) set(mask BasicACL, v bool) {
+	if v {
+		*a |= mask
+	} else {
+		*a &^= mask
+	}
+}
+
+// Allowed checks whether the role is allowed to perform the operation.
+//
+// Returns false for unknown roles and operations.
+func (a BasicACL) Allowed(role Role, op Operation) bool {
+	bit, ok := basicACLRoleBit(role)
+	if !ok {
+		return false
+	}
+
+	mask, ok := basicACLOpBit(op, bit)
+
+	return ok && a.isSet(mask)
+}
+
+// SetAllowed allows or forbids the role to perform the operation.
+//
+// Unknown roles and operations are ignored.
+func (a *BasicACL) SetAllowed(role Role, op Operation, v bool) {
+	bit, ok := basicACLRoleBit(role)
+	if !ok {
+		return
+	}
+
+	if mask, ok := basicACLOpBit(op, bit); ok {
+		a.set(mask, v)
+	}
+}
+
+// BearerAllowed checks whether bearer token rules are allowed
+// for the operation.
+//
+// Returns false for unknown operations.
+func (a BasicACL) BearerAllowed(op Operation) bool {
+	mask, ok := basicACLOpBit(op, basicACLBearerBit)
+
+	return ok && a.isSet(mask)
+}
+
+// SetBearerAllowed allows or forbids bearer token rules for the operation.
+//
+// Unknown operations are ignored.
+func (a *BasicACL) SetBearerAllowed(op Operation, v bool) {
+	if mask, ok := basicACLOpBit(op, basicACLBearerBit); ok {
+		a.set(mask, v)
+	}
+}
+
+// Sticky checks whether the sticky bit is set.
+func (a BasicACL) Sticky() bool {
+	return a.isSet(1 << basicACLStickyBit)
+}
+
+// SetSticky sets or clears the sticky bit.
+func (a *BasicACL) SetSticky(v bool) {
+	a.set(1<<basicACLStickyBit, v)
+}
+
+// Final checks whether the final bit is set, i.e. extended ACL is
+// prohibited.
+func (a BasicACL) Final() bool {
+	return a.isSet(1 << basicACLFinalBit)
+}
+
+// SetFinal sets or clears the final bit.
+func (a *BasicACL) SetFinal(v bool) {
+	a.set(1<<basicACLFinalBit, v)
+}
+
+// String returns hexadecimal form of the BasicACL, e.g. 0x1c8c8ccc.
+func (a BasicACL) String() string {
+	return fmt.Sprintf("0x%08x", uint32(a))
+}
+
+// HumanString returns human-readable form of the BasicACL.
+//
+// The form consists of space-separated flags section and operation
+// sections. Flags section contains X if sticky bit is set and F if
+// final bit is set. Operation section is the operation name followed
+// by colon and letters U, S, O and B of the set bits. Unset flags and
+// bits are replaced with hyphen. For example, BasicACLPrivate is
+//
+//	-F GET:US-- HEAD:US-- PUT:US-- DELETE:U--- SEARCH:US-- RANGE:U--- RANGEHASH:US--
+//
+// Reserved bits are not represented.
+func (a BasicACL) HumanString() string {
+	b := new(strings.Builder)
+
+	b.WriteByte(flagLetter(a.Sticky(), 'X'))
+	b.WriteByte(flagLetter(a.Final(), 'F'))
+
+	for _, o := range basicACLOperations {
+		b.WriteString(" " + o.name + ":")
+
+		for i := range basicACLOpLetters {
+			mask, _ := basicACLOpBit(o.op, basicACLUserBit-i)
+			b.WriteByte(flagLetter(a.isSet(mask), basicACLOpLetters[i]))
+		}
+	}
+
+	return b.String()
+}
+
+// PresetName returns the name of the preset equal to the BasicACL,
+// e.g. public-read for BasicACLPublicRead.
+//
+// Returns false if the BasicACL is not a preset.
+func (a BasicACL) PresetName() (string, bool) {
+	for _, p := range basicACLPresets {
+		if a == p.val {
+			return p.name, true
+		}
+	}
+
+	return "", false
+}
+
+func flagLetter(v bool, c byte) byte {
+	if v {
+		return c
+	}
+
+	return '-'
+}
+
+// ParseBasicACL parses BasicACL from the preset name (e.g. private or
+// eacl-public-read), hexadecimal number with 0x prefix or human-readable
+// form returned by HumanString.
+//
+// All operations must be present in human-readable form.
+func ParseBasicACL(s string) (BasicACL, error) {
+	for _, p := range basicACLPresets {
+		if s == p.name {
+			return p.val, nil
+		}
+	}
+
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		v, err := strconv.ParseUint(s[2:], 16, 32)
+		if err != nil {
+			return 0, errors.Errorf("invalid hexadecimal basic ACL %q", s)
+		}
+
+		return BasicACL(v), nil
+	}
+
+	return parseHumanBasicACL(s)
+}
+
+func parseHumanBasicACL(s string) (BasicACL, error) {
+	fields := strings.Fields(s)
+	if len(fields) != len(basicACLOperations)+1 {
+		return 0, errors.Errorf("invalid basic ACL %q: expected preset name, hexadecimal number or %d sections, got %d sections",
+			s, len(basicACLOperations)+1, len(fields))
+	}
+
+	var a BasicACL
+
+	flags := fields[0]
+	if len(flags) != 2 || !isFlagLetter(flags[0], 'X') || !isFlagLetter(flags[1], 'F') {
+		return 0, errors.Errorf("invalid flags section %q: expected [X-][F-]", flags)
+	}
+
+	a.SetSticky(flags[0] == 'X')
+	a.SetFinal(flags[1] == 'F')
+
+	seen := make(map[Operation]struct{}, len(basicACLOperations))
+
+	for _, f := range fields[1:] {
+		i := strings.IndexByte(f, ':')
+		if i < 0 {
+			return 0, errors.Errorf("invalid operation section %q: missing colon", f)
+		}
+
+		op, ok := basicACLOperationByName(f[:i])
+		if !ok {
+			return 0, errors.Errorf("invalid operation section %q: unknown operation %s", f, f[:i])
+		}
+
+		if _, ok := seen[op]; ok {
+			return 0, errors.Errorf("duplicate operation section %s", f[:i])
+		}
+
+		seen[op] = struct{}{}
+
+		bits := f[i+1:]
+		if len(bits) != len(basicACLOpLetters) {
+			return 0, errors.Errorf("invalid operation section %q: expected %d bits, got %d",
+				f, len(basicACLOpLetters), len(bits))
+		}
+
+		for j := range bits {
+			if !isFlagLetter(bits[j], basicACLOpLetters[j]) {
+				return 0, errors.Errorf("invalid operation section %q: expected %c or - at position %d",
+					f, basicACLOpLetters[j], j+1)
+			}
+
+			mask, _ := basicACLOpBit(op, basicACLUserBit-j)
+			a.set(mask, bits[j] != '-')
+		}
+	}
+
+	return a, nil
+}
+
+func isFlagLetter(c, letter byte) bool {
+	return c == letter || c == '-'
+}
+
+func basicACLOperationByName(name string) (Operation, bool) {
+	for _, o := range basicACLOperations {
+		if o.name == name {
+			return o.op, true
+		}
+	}
+
+	return OperationUnknown, false
+}
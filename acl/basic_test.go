@@ -0,0 +1,115 @@
+package acl_test
+
+import (
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/acl"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBasicACL_Allowed(t *testing.T) {
+	a := acl.BasicACLPrivate
+
+	require.True(t, a.Final())
+	require.False(t, a.Sticky())
+
+	for op := acl.OperationGet; op <= acl.OperationRangeHash; op++ {
+		require.True(t, a.Allowed(acl.RoleUser, op))
+		require.False(t, a.Allowed(acl.RoleOthers, op))
+		require.False(t, a.BearerAllowed(op))
+	}
+
+	require.True(t, a.Allowed(acl.RoleSystem, acl.OperationGet))
+	require.False(t, a.Allowed(acl.RoleSystem, acl.OperationDelete))
+
+	require.False(t, a.Allowed(acl.RoleUnknown, acl.OperationGet))
+	require.False(t, a.Allowed(acl.RoleUser, acl.OperationUnknown))
+
+	pub := acl.BasicACLPublicRead
+	require.True(t, pub.Allowed(acl.RoleOthers, acl.OperationGet))
+	require.True(t, pub.BearerAllowed(acl.OperationGet))
+	require.False(t, pub.Allowed(acl.RoleOthers, acl.OperationPut))
+	require.True(t, acl.BasicACLPublicAppend.Allowed(acl.RoleOthers, acl.OperationPut))
+	require.False(t, acl.BasicACLPublicAppend.Allowed(acl.RoleOthers, acl.OperationDelete))
+	require.False(t, acl.BasicACLEACLPublicRead.Final())
+}
+
+func TestBasicACL_Set(t *testing.T) {
+	var a acl.BasicACL
+
+	a.SetAllowed(acl.RoleOthers, acl.OperationGet, true)
+	require.EqualValues(t, 0x2, a)
+
+	a.SetBearerAllowed(acl.OperationRangeHash, true)
+	require.EqualValues(t, 0x01000002, a)
+
+	a.SetSticky(true)
+	a.SetFinal(true)
+	require.EqualValues(t, 0x31000002, a)
+
+	a.SetAllowed(acl.RoleOthers, acl.OperationGet, false)
+	a.SetFinal(false)
+	a.SetAllowed(acl.RoleUnknown, acl.OperationGet, true)
+	require.EqualValues(t, 0x21000000, a)
+}
+
+func TestBasicACL_String(t *testing.T) {
+	a := acl.BasicACLPrivate
+
+	require.Equal(t, "0x1c8c8ccc", a.String())
+	require.Equal(t, "-F GET:US-- HEAD:US-- PUT:US-- DELETE:U--- SEARCH:US-- RANGE:U--- RANGEHASH:US--", a.HumanString())
+
+	name, ok := a.PresetName()
+	require.True(t, ok)
+	require.Equal(t, "private", name)
+
+	_, ok = acl.BasicACL(1).PresetName()
+	require.False(t, ok)
+
+	for _, v := range []acl.BasicACL{
+		acl.BasicACLPrivate,
+		acl.BasicACLPublicRead,
+		acl.BasicACLPublicReadWrite,
+		acl.BasicACLPublicAppend,
+		acl.BasicACLEACLPrivate,
+		acl.BasicACLEACLPublicRead,
+		acl.BasicACLEACLPublicReadWrite,
+		acl.BasicACLEACLPublicAppend,
+		0x20000001,
+	} {
+		name, ok := v.PresetName()
+		if ok {
+			parsed, err := acl.ParseBasicACL(name)
+			require.NoError(t, err)
+			require.Equal(t, v, parsed)
+		}
+
+		parsed, err := acl.ParseBasicACL(v.String())
+		require.NoError(t, err)
+		require.Equal(t, v, parsed)
+
+		parsed, err = acl.ParseBasicACL(v.HumanString())
+		require.NoError(t, err)
+		require.Equal(t, v, parsed)
+	}
+}
+
+func TestParseBasicACL_Errors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"protected",
+		"0x",
+		"0x1ffffffff",
+		"0xzz",
+		"-F GET:US-- HEAD:US-- PUT:US-- DELETE:U--- SEARCH:US-- RANGE:U---",
+		"FX GET:US-- HEAD:US-- PUT:US-- DELETE:U--- SEARCH:US-- RANGE:U--- RANGEHASH:US--",
+		"-F GET:US-- GET:US-- PUT:US-- DELETE:U--- SEARCH:US-- RANGE:U--- RANGEHASH:US--",
+		"-F GET:SU-- HEAD:US-- PUT:US-- DELETE:U--- SEARCH:US-- RANGE:U--- RANGEHASH:US--",
+		"-F GET:US- HEAD:US-- PUT:US-- DELETE:U--- SEARCH:US-- RANGE:U--- RANGEHASH:US--",
+		"-F GET US-- HEAD:US-- PUT:US-- DELETE:U--- SEARCH:US-- RANGE:U--- RANGEHASH:US--",
+		"-F LIST:US-- HEAD:US-- PUT:US-- DELETE:U--- SEARCH:US-- RANGE:U--- RANGEHASH:US--",
+	} {
+		_, err := acl.ParseBasicACL(s)
+		require.Error(t, err, s)
+	}
+}
@@ -1,9 +1,8 @@
 package acl
 
 import (
-	acl "github.com/cthulhu-rider/neofs-api-go/v2/acl/grpc"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
 	protoutil "github.com/cthulhu-rider/neofs-api-go/v2/util/proto"
-	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -94,15 +93,34 @@ func (t *Table) StableSize() (size int) {
 	return size
 }
 
-func (t *Table) Unmarshal(data []byte) error {
-	m := new(acl.EACLTable)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
+func (t *Table) StableUnmarshal(data []byte) error {
+	if t == nil {
+		return nil
 	}
 
-	*t = *TableFromGRPCMessage(m)
+	*t = Table{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case tableVersionField:
+			t.version = new(refs.Version)
+			err = f.Nested(t.version)
+		case tableContainerIDField:
+			t.cid = new(refs.ContainerID)
+			err = f.Nested(t.cid)
+		case tableRecordsField:
+			v := new(Record)
+			t.records = append(t.records, v)
+
+			err = f.Nested(v)
+		}
+
+		return
+	})
+}
 
-	return nil
+func (t *Table) Unmarshal(data []byte) error {
+	return t.StableUnmarshal(data)
 }
 
 // StableMarshal marshals unified acl record structure in a protobuf
@@ -176,15 +194,43 @@ func (r *Record) StableSize() (size int) {
 	return size
 }
 
-func (r *Record) Unmarshal(data []byte) error {
-	m := new(acl.EACLRecord)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
+func (r *Record) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
 	}
 
-	*r = *RecordFromGRPCMessage(m)
+	*r = Record{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case recordOperationField:
+			var v int32
+
+			v, err = f.Enum()
+			r.op = Operation(v)
+		case recordActionField:
+			var v int32
 
-	return nil
+			v, err = f.Enum()
+			r.action = Action(v)
+		case recordFiltersField:
+			v := new(HeaderFilter)
+			r.filters = append(r.filters, v)
+
+			err = f.Nested(v)
+		case recordTargetsField:
+			v := new(Target)
+			r.targets = append(r.targets, v)
+
+			err = f.Nested(v)
+		}
+
+		return
+	})
+}
+
+func (r *Record) Unmarshal(data []byte) error {
+	return r.StableUnmarshal(data)
 }
 
 // StableMarshal marshals unified header filter structure in a protobuf
@@ -246,15 +292,37 @@ func (f *HeaderFilter) StableSize() (size int) {
 	return size
 }
 
-func (f *HeaderFilter) Unmarshal(data []byte) error {
-	m := new(acl.EACLRecord_Filter)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
+func (f *HeaderFilter) StableUnmarshal(data []byte) error {
+	if f == nil {
+		return nil
 	}
 
-	*f = *HeaderFilterFromGRPCMessage(m)
+	*f = HeaderFilter{}
+
+	return protoutil.UnmarshalFields(data, func(fld protoutil.Field) (err error) {
+		switch fld.Num {
+		case filterHeaderTypeField:
+			var v int32
 
-	return nil
+			v, err = fld.Enum()
+			f.hdrType = HeaderType(v)
+		case filterMatchTypeField:
+			var v int32
+
+			v, err = fld.Enum()
+			f.matchType = MatchType(v)
+		case filterNameField:
+			f.key, err = fld.StringValue()
+		case filterValueField:
+			f.value, err = fld.StringValue()
+		}
+
+		return
+	})
+}
+
+func (f *HeaderFilter) Unmarshal(data []byte) error {
+	return f.StableUnmarshal(data)
 }
 
 // StableMarshal marshals unified role info structure in a protobuf
@@ -300,15 +368,33 @@ func (t *Target) StableSize() (size int) {
 	return size
 }
 
-func (t *Target) Unmarshal(data []byte) error {
-	m := new(acl.EACLRecord_Target)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
+func (t *Target) StableUnmarshal(data []byte) error {
+	if t == nil {
+		return nil
 	}
 
-	*t = *TargetInfoFromGRPCMessage(m)
+	*t = Target{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case targetTypeField:
+			var v int32
+
+			v, err = f.Enum()
+			t.role = Role(v)
+		case targetKeysField:
+			var v []byte
+
+			v, err = f.Bytes()
+			t.keys = append(t.keys, v)
+		}
+
+		return
+	})
+}
 
-	return nil
+func (t *Target) Unmarshal(data []byte) error {
+	return t.StableUnmarshal(data)
 }
 
 func (l *TokenLifetime) StableMarshal(buf []byte) ([]byte, error) {
@@ -359,15 +445,29 @@ func (l *TokenLifetime) StableSize() (size int) {
 	return size
 }
 
-func (l *TokenLifetime) Unmarshal(data []byte) error {
-	m := new(acl.BearerToken_Body_TokenLifetime)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
+func (l *TokenLifetime) StableUnmarshal(data []byte) error {
+	if l == nil {
+		return nil
 	}
 
-	*l = *TokenLifetimeFromGRPCMessage(m)
+	*l = TokenLifetime{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case lifetimeExpirationField:
+			l.exp, err = f.UInt64()
+		case lifetimeNotValidBeforeField:
+			l.nbf, err = f.UInt64()
+		case lifetimeIssuedAtField:
+			l.iat, err = f.UInt64()
+		}
+
+		return
+	})
+}
 
-	return nil
+func (l *TokenLifetime) Unmarshal(data []byte) error {
+	return l.StableUnmarshal(data)
 }
 
 func (bt *BearerTokenBody) StableMarshal(buf []byte) ([]byte, error) {
@@ -418,15 +518,32 @@ func (bt *BearerTokenBody) StableSize() (size int) {
 	return size
 }
 
-func (bt *BearerTokenBody) Unmarshal(data []byte) error {
-	m := new(acl.BearerToken_Body)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
-	}
+func (bt *BearerTokenBody) StableUnmarshal(data []byte) error {
+	if bt == nil {
+		return nil
+	}
+
+	*bt = BearerTokenBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case bearerTokenBodyACLField:
+			bt.eacl = new(Table)
+			err = f.Nested(bt.eacl)
+		case bearerTokenBodyOwnerField:
+			bt.ownerID = new(refs.OwnerID)
+			err = f.Nested(bt.ownerID)
+		case bearerTokenBodyLifetimeField:
+			bt.lifetime = new(TokenLifetime)
+			err = f.Nested(bt.lifetime)
+		}
 
-	*bt = *BearerTokenBodyFromGRPCMessage(m)
+		return
+	})
+}
 
-	return nil
+func (bt *BearerTokenBody) Unmarshal(data []byte) error {
+	return bt.StableUnmarshal(data)
 }
 
 func (bt *BearerToken) StableMarshal(buf []byte) ([]byte, error) {
@@ -469,13 +586,27 @@ func (bt *BearerToken) StableSize() (size int) {
 	return size
 }
 
-func (bt *BearerToken) Unmarshal(data []byte) error {
-	m := new(acl.BearerToken)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
+func (bt *BearerToken) StableUnmarshal(data []byte) error {
+	if bt == nil {
+		return nil
 	}
 
-	*bt = *BearerTokenFromGRPCMessage(m)
+	*bt = BearerToken{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case bearerTokenBodyField:
+			bt.body = new(BearerTokenBody)
+			err = f.Nested(bt.body)
+		case bearerTokenSignatureField:
+			bt.sig = new(refs.Signature)
+			err = f.Nested(bt.sig)
+		}
 
-	return nil
+		return
+	})
+}
+
+func (bt *BearerToken) Unmarshal(data []byte) error {
+	return bt.StableUnmarshal(data)
 }
@@ -129,6 +129,10 @@ func TestHeaderFilter_StableMarshal(t *testing.T) {
 		require.NoError(t, filterTo.Unmarshal(wire))
 
 		require.Equal(t, filterFrom, filterTo)
+
+		filterTo = new(acl.HeaderFilter)
+		require.NoError(t, filterTo.StableUnmarshal(wire))
+		require.Equal(t, filterFrom, filterTo)
 	})
 }
 
@@ -149,6 +153,10 @@ func TestTargetInfo_StableMarshal(t *testing.T) {
 		require.NoError(t, targetTo.Unmarshal(wire))
 
 		require.Equal(t, targetFrom, targetTo)
+
+		targetTo = new(acl.Target)
+		require.NoError(t, targetTo.StableUnmarshal(wire))
+		require.Equal(t, targetFrom, targetTo)
 	})
 }
 
@@ -163,6 +171,10 @@ func TestRecord_StableMarshal(t *testing.T) {
 		require.NoError(t, to.Unmarshal(wire))
 
 		require.Equal(t, recordFrom, to)
+
+		to = new(acl.Record)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, recordFrom, to)
 	})
 }
 
@@ -191,6 +203,10 @@ func TestTable_StableMarshal(t *testing.T) {
 		require.NoError(t, tableTo.Unmarshal(wire))
 
 		require.Equal(t, tableFrom, tableTo)
+
+		tableTo = new(acl.Table)
+		require.NoError(t, tableTo.StableUnmarshal(wire))
+		require.Equal(t, tableFrom, tableTo)
 	})
 }
 
@@ -205,6 +221,10 @@ func TestTokenLifetime_StableMarshal(t *testing.T) {
 		require.NoError(t, lifetimeTo.Unmarshal(wire))
 
 		require.Equal(t, lifetimeFrom, lifetimeTo)
+
+		lifetimeTo = new(acl.TokenLifetime)
+		require.NoError(t, lifetimeTo.StableUnmarshal(wire))
+		require.Equal(t, lifetimeFrom, lifetimeTo)
 	})
 }
 
@@ -219,6 +239,10 @@ func TestBearerTokenBody_StableMarshal(t *testing.T) {
 		require.NoError(t, bearerTokenBodyTo.Unmarshal(wire))
 
 		require.Equal(t, bearerTokenBodyFrom, bearerTokenBodyTo)
+
+		bearerTokenBodyTo = new(acl.BearerTokenBody)
+		require.NoError(t, bearerTokenBodyTo.StableUnmarshal(wire))
+		require.Equal(t, bearerTokenBodyFrom, bearerTokenBodyTo)
 	})
 }
 
@@ -233,5 +257,9 @@ func TestBearerToken_StableMarshal(t *testing.T) {
 		require.NoError(t, bearerTokenTo.Unmarshal(wire))
 
 		require.Equal(t, bearerTokenFrom, bearerTokenTo)
+
+		bearerTokenTo = new(acl.BearerToken)
+		require.NoError(t, bearerTokenTo.StableUnmarshal(wire))
+		require.Equal(t, bearerTokenFrom, bearerTokenTo)
 	})
 }
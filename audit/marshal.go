@@ -1,10 +1,8 @@
 package audit
 
 import (
-	audit "github.com/cthulhu-rider/neofs-api-go/v2/audit/grpc"
 	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
 	"github.com/cthulhu-rider/neofs-api-go/v2/util/proto"
-	goproto "google.golang.org/protobuf/proto"
 )
 
 const (
@@ -165,15 +163,67 @@ func (a *DataAuditResult) StableSize() (size int) {
 	return size
 }
 
+// StableUnmarshal unmarshals DataAuditResult structure from its protobuf
+// binary representation.
+func (a *DataAuditResult) StableUnmarshal(data []byte) error {
+	if a == nil {
+		return nil
+	}
+
+	*a = DataAuditResult{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case versionFNum:
+			a.version = new(refs.Version)
+			err = f.Nested(a.version)
+		case auditEpochFNum:
+			a.auditEpoch, err = f.Fixed64()
+		case cidFNum:
+			a.cid = new(refs.ContainerID)
+			err = f.Nested(a.cid)
+		case pubKeyFNum:
+			a.pubKey, err = f.Bytes()
+		case completeFNum:
+			a.complete, err = f.Bool()
+		case requestsFNum:
+			a.requests, err = f.UInt32()
+		case retriesFNum:
+			a.retries, err = f.UInt32()
+		case passSGFNum:
+			v := new(refs.ObjectID)
+			a.passSG = append(a.passSG, v)
+
+			err = f.Nested(v)
+		case failSGFNum:
+			v := new(refs.ObjectID)
+			a.failSG = append(a.failSG, v)
+
+			err = f.Nested(v)
+		case hitFNum:
+			a.hit, err = f.UInt32()
+		case missFNum:
+			a.miss, err = f.UInt32()
+		case failFNum:
+			a.fail, err = f.UInt32()
+		case passNodesFNum:
+			var v []byte
+
+			v, err = f.Bytes()
+			a.passNodes = append(a.passNodes, v)
+		case failNodesFNum:
+			var v []byte
+
+			v, err = f.Bytes()
+			a.failNodes = append(a.failNodes, v)
+		}
+
+		return
+	})
+}
+
 // Unmarshal unmarshals DataAuditResult structure from its protobuf
 // binary representation.
 func (a *DataAuditResult) Unmarshal(data []byte) error {
-	m := new(audit.DataAuditResult)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
-	}
-
-	*a = *DataAuditResultFromGRPCMessage(m)
-
-	return nil
+	return a.StableUnmarshal(data)
 }
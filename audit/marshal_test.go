@@ -19,6 +19,10 @@ func TestDataAuditResult_StableMarshal(t *testing.T) {
 		require.NoError(t, to.Unmarshal(wire))
 
 		require.Equal(t, from, to)
+
+		to = new(audit.DataAuditResult)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, from, to)
 	})
 }
 
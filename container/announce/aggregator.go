@@ -0,0 +1,362 @@
+/*
+Package announce contains aggregator of the container used space
+estimations which produces AnnounceUsedSpace requests.
+*/
+package announce
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/container"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/session"
+	"github.com/cthulhu-rider/neofs-api-go/v2/signature"
+	protoutil "github.com/cthulhu-rider/neofs-api-go/v2/util/proto"
+	utilsig "github.com/cthulhu-rider/neofs-api-go/v2/util/signature"
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/pkg/errors"
+)
+
+// Handler is a handler of the signed request batches.
+type Handler func(*container.AnnounceUsedSpaceRequest) error
+
+// Aggregator collects used space estimations of the containers made by
+// different nodes during the epoch and merges them into announcements.
+//
+// When the estimation of the newer epoch is reported, announcements of
+// the current epoch are packed into signed AnnounceUsedSpaceRequest
+// batches and passed to the Handler.
+//
+// Aggregator is safe for concurrent use.
+type Aggregator struct {
+	cfg *cfg
+
+	key *ecdsa.PrivateKey
+
+	// reporter is a binary public key of the local node.
+	reporter string
+
+	handler Handler
+
+	mtx sync.Mutex
+
+	epoch uint64
+
+	// flushed is set when the current epoch is closed for new reports.
+	flushed bool
+
+	estimations map[string]*estimation
+
+	// pending contains signed requests of the flushed epochs
+	// which have not been handled yet.
+	pending []*container.AnnounceUsedSpaceRequest
+}
+
+type estimation struct {
+	cid *refs.ContainerID
+
+	// sizes maps binary public keys of the reporters
+	// to their estimations.
+	sizes map[string]uint64
+}
+
+// Option is an Aggregator configuration option.
+type Option func(*cfg)
+
+type cfg struct {
+	strategy Strategy
+
+	maxSize int
+
+	meta *session.RequestMetaHeader
+
+	signOpts []utilsig.SignOption
+}
+
+// DefaultMaxSize is a default maximum size of the signed request.
+const DefaultMaxSize = 1 << 20
+
+// ErrStaleEpoch is returned when the estimation of the already
+// flushed epoch is reported.
+var ErrStaleEpoch = errors.New("stale epoch")
+
+// ErrTooBigAnnouncement is returned when the signed request with
+// a single announcement exceeds maximum request size.
+var ErrTooBigAnnouncement = errors.New("announcement exceeds maximum request size")
+
+func defaultCfg() *cfg {
+	return &cfg{
+		strategy: Sum,
+		maxSize:  DefaultMaxSize,
+	}
+}
+
+// WithStrategy returns option to set the strategy of merging estimations
+// of the container. By default, Sum is used.
+func WithStrategy(v Strategy) Option {
+	return func(c *cfg) {
+		c.strategy = v
+	}
+}
+
+// WithMaxSize returns option to set maximum size of the signed request
+// in bytes according to StableSize. Size includes meta and verification
+// headers.
+func WithMaxSize(v int) Option {
+	return func(c *cfg) {
+		c.maxSize = v
+	}
+}
+
+// WithMetaHeader returns option to attach meta header to requests.
+func WithMetaHeader(v *session.RequestMetaHeader) Option {
+	return func(c *cfg) {
+		c.meta = v
+	}
+}
+
+// WithSignOptions returns option to set request signature options.
+func WithSignOptions(v ...utilsig.SignOption) Option {
+	return func(c *cfg) {
+		c.signOpts = v
+	}
+}
+
+// NewAggregator creates new Aggregator which signs requests with the key
+// and passes them to the handler.
+func NewAggregator(key *ecdsa.PrivateKey, handler Handler, opts ...Option) *Aggregator {
+	c := defaultCfg()
+
+	for i := range opts {
+		opts[i](c)
+	}
+
+	return &Aggregator{
+		cfg:         c,
+		key:         key,
+		reporter:    string(crypto.MarshalPublicKey(&key.PublicKey)),
+		handler:     handler,
+		estimations: make(map[string]*estimation),
+	}
+}
+
+// Epoch returns current epoch of the Aggregator.
+func (a *Aggregator) Epoch() uint64 {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	return a.epoch
+}
+
+// Report adds the estimation of used space of the container in the epoch
+// made by the local node. Repeated report of the container replaces the
+// previous one.
+//
+// If the epoch is newer than the current one, current epoch is flushed
+// first. The estimation is recorded even if the flush fails, failed
+// requests are retried on the next flush. Returns an error wrapping
+// ErrStaleEpoch if the epoch is older than the current one or has
+// already been flushed.
+func (a *Aggregator) Report(epoch uint64, cid *refs.ContainerID, size uint64) error {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	return a.report(a.reporter, epoch, cid, size)
+}
+
+// ReportAnnouncements adds estimations from the announcements made
+// by the node with the public key. All announcements are processed,
+// the first error is returned. See Report.
+func (a *Aggregator) ReportAnnouncements(reporter *ecdsa.PublicKey, as []*container.UsedSpaceAnnouncement) error {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	var (
+		key  = string(crypto.MarshalPublicKey(reporter))
+		errs error
+	)
+
+	for _, ann := range as {
+		if err := a.report(key, ann.GetEpoch(), ann.GetContainerID(), ann.GetUsedSpace()); err != nil && errs == nil {
+			errs = err
+		}
+	}
+
+	return errs
+}
+
+func (a *Aggregator) report(reporter string, epoch uint64, cid *refs.ContainerID, size uint64) error {
+	if epoch < a.epoch || epoch == a.epoch && a.flushed {
+		return errors.Wrapf(ErrStaleEpoch, "%d, current %d", epoch, a.epoch)
+	}
+
+	var err error
+
+	if epoch > a.epoch {
+		err = a.flush()
+		a.epoch, a.flushed = epoch, false
+	}
+
+	key := hex.EncodeToString(cid.GetValue())
+
+	e, ok := a.estimations[key]
+	if !ok {
+		e = &estimation{
+			cid:   cid,
+			sizes: make(map[string]uint64),
+		}
+		a.estimations[key] = e
+	}
+
+	e.sizes[reporter] = size
+
+	return err
+}
+
+// Flush merges estimations of the current epoch and passes resulting
+// requests to the handler. After Flush, reports of the current epoch
+// are rejected with ErrStaleEpoch.
+//
+// If the handler fails, unhandled requests are kept and passed to it
+// again on the next flush. Estimations which cannot be packed into
+// requests are discarded.
+func (a *Aggregator) Flush() error {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	return a.flush()
+}
+
+func (a *Aggregator) flush() error {
+	if !a.flushed {
+		a.flushed = true
+
+		if len(a.estimations) > 0 {
+			reqs, err := a.requests()
+
+			a.estimations = make(map[string]*estimation)
+
+			if err != nil {
+				return err
+			}
+
+			a.pending = append(a.pending, reqs...)
+		}
+	}
+
+	for len(a.pending) > 0 {
+		if err := a.handler(a.pending[0]); err != nil {
+			return errors.Wrap(err, "could not handle request")
+		}
+
+		a.pending[0] = nil
+		a.pending = a.pending[1:]
+	}
+
+	return nil
+}
+
+// requests returns signed requests with announcements of the current
+// epoch ordered by container ID.
+func (a *Aggregator) requests() ([]*container.AnnounceUsedSpaceRequest, error) {
+	keys := make([]string, 0, len(a.estimations))
+	for key := range a.estimations {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	limit, err := a.bodyLimit()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		reqs  []*container.AnnounceUsedSpaceRequest
+		batch []*container.UsedSpaceAnnouncement
+		size  int
+		sizes []uint64
+	)
+
+	for _, key := range keys {
+		e := a.estimations[key]
+
+		sizes = sizes[:0]
+		for _, v := range e.sizes {
+			sizes = append(sizes, v)
+		}
+
+		ann := new(container.UsedSpaceAnnouncement)
+		ann.SetEpoch(a.epoch)
+		ann.SetContainerID(e.cid)
+		ann.SetUsedSpace(a.cfg.strategy(sizes))
+
+		annSize := announcementSize(ann)
+		if annSize > limit {
+			return nil, errors.Wrapf(ErrTooBigAnnouncement, "%d > %d", annSize, limit)
+		}
+
+		if size+annSize > limit {
+			req, err := a.request(batch)
+			if err != nil {
+				return nil, err
+			}
+
+			reqs = append(reqs, req)
+			batch, size = nil, 0
+		}
+
+		batch = append(batch, ann)
+		size += annSize
+	}
+
+	req, err := a.request(batch)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(reqs, req), nil
+}
+
+// bodyLimit returns maximum size of the request body which keeps the
+// signed request within the maximum size.
+//
+// Signatures have fixed length, so the headers of the signed request
+// with empty body are of the same size as in any other request. Only
+// the length prefix of the body grows with it, so the room for its
+// largest value is reserved.
+func (a *Aggregator) bodyLimit() (int, error) {
+	req, err := a.request(nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return a.cfg.maxSize - req.StableSize() - protoutil.VarUIntSize(uint64(a.cfg.maxSize)), nil
+}
+
+func (a *Aggregator) request(batch []*container.UsedSpaceAnnouncement) (*container.AnnounceUsedSpaceRequest, error) {
+	body := new(container.AnnounceUsedSpaceRequestBody)
+	body.SetAnnouncements(batch)
+
+	req := new(container.AnnounceUsedSpaceRequest)
+	req.SetBody(body)
+	req.SetMetaHeader(a.cfg.meta)
+
+	if err := signature.SignServiceMessage(a.key, req, a.cfg.signOpts...); err != nil {
+		return nil, errors.Wrap(err, "could not sign request")
+	}
+
+	return req, nil
+}
+
+// announcementSize returns the size which the announcement adds to the
+// request body.
+func announcementSize(ann *container.UsedSpaceAnnouncement) int {
+	body := new(container.AnnounceUsedSpaceRequestBody)
+	body.SetAnnouncements([]*container.UsedSpaceAnnouncement{ann})
+
+	return body.StableSize()
+}
@@ -0,0 +1,268 @@
+package announce_test
+
+import (
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/container"
+	"github.com/cthulhu-rider/neofs-api-go/v2/container/announce"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/session"
+	"github.com/cthulhu-rider/neofs-api-go/v2/signature"
+	utilsig "github.com/cthulhu-rider/neofs-api-go/v2/util/signature"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrategies(t *testing.T) {
+	require.EqualValues(t, 10, announce.Sum([]uint64{1, 2, 3, 4}))
+	require.EqualValues(t, ^uint64(0), announce.Sum([]uint64{^uint64(0), 1}))
+
+	require.EqualValues(t, 3, announce.Median([]uint64{5, 1, 3}))
+	require.EqualValues(t, 2, announce.Median([]uint64{4, 1, 3, 1}))
+
+	tm := announce.TrimmedMean(0.25)
+	require.EqualValues(t, 3, tm([]uint64{100, 2, 4, 0}))
+	require.EqualValues(t, ^uint64(0), tm([]uint64{^uint64(0), ^uint64(0)}))
+	require.EqualValues(t, 7, announce.TrimmedMean(0.5)([]uint64{7}))
+}
+
+func containerID(b byte) *refs.ContainerID {
+	cid := new(refs.ContainerID)
+	cid.SetValue([]byte{b})
+
+	return cid
+}
+
+func announcement(epoch uint64, cid *refs.ContainerID, size uint64) *container.UsedSpaceAnnouncement {
+	ann := new(container.UsedSpaceAnnouncement)
+	ann.SetEpoch(epoch)
+	ann.SetContainerID(cid)
+	ann.SetUsedSpace(size)
+
+	return ann
+}
+
+func TestAggregator(t *testing.T) {
+	var reqs []*container.AnnounceUsedSpaceRequest
+
+	a := announce.NewAggregator(test.DecodeKey(0), func(req *container.AnnounceUsedSpaceRequest) error {
+		reqs = append(reqs, req)
+		return nil
+	},
+		announce.WithStrategy(announce.Median),
+		announce.WithSignOptions(utilsig.SignWithRFC6979()),
+	)
+
+	require.NoError(t, a.Report(1, containerID(2), 10))
+	require.NoError(t, a.Report(1, containerID(1), 5))
+	require.NoError(t, a.ReportAnnouncements(&test.DecodeKey(1).PublicKey, []*container.UsedSpaceAnnouncement{
+		announcement(1, containerID(2), 20),
+	}))
+	require.NoError(t, a.ReportAnnouncements(&test.DecodeKey(2).PublicKey, []*container.UsedSpaceAnnouncement{
+		announcement(1, containerID(2), 40),
+	}))
+	require.Empty(t, reqs)
+
+	require.NoError(t, a.Report(2, containerID(1), 1))
+	require.Len(t, reqs, 1)
+	require.EqualValues(t, 2, a.Epoch())
+
+	require.NoError(t, signature.VerifyServiceMessage(reqs[0], utilsig.SignWithRFC6979()))
+
+	anns := reqs[0].GetBody().GetAnnouncements()
+	require.Len(t, anns, 2)
+	require.Equal(t, containerID(1), anns[0].GetContainerID())
+	require.EqualValues(t, 5, anns[0].GetUsedSpace())
+	require.Equal(t, containerID(2), anns[1].GetContainerID())
+	require.EqualValues(t, 20, anns[1].GetUsedSpace())
+	require.EqualValues(t, 1, anns[1].GetEpoch())
+
+	err := a.Report(1, containerID(1), 1)
+	require.True(t, errors.Is(err, announce.ErrStaleEpoch))
+
+	require.NoError(t, a.Flush())
+	require.Len(t, reqs, 2)
+	require.EqualValues(t, 2, reqs[1].GetBody().GetAnnouncements()[0].GetEpoch())
+
+	err = a.Report(2, containerID(1), 1)
+	require.True(t, errors.Is(err, announce.ErrStaleEpoch))
+
+	require.NoError(t, a.Flush())
+	require.Len(t, reqs, 2)
+
+	require.NoError(t, a.Report(3, containerID(1), 1))
+	require.NoError(t, a.Flush())
+	require.Len(t, reqs, 3)
+}
+
+func TestAggregator_ReportAnnouncements(t *testing.T) {
+	var reqs []*container.AnnounceUsedSpaceRequest
+
+	a := announce.NewAggregator(test.DecodeKey(0), func(req *container.AnnounceUsedSpaceRequest) error {
+		reqs = append(reqs, req)
+		return nil
+	},
+		announce.WithSignOptions(utilsig.SignWithRFC6979()),
+	)
+
+	ann := announcement(1, containerID(1), 10)
+	anns := []*container.UsedSpaceAnnouncement{ann}
+
+	require.NoError(t, a.Report(1, containerID(1), 1))
+	require.NoError(t, a.Report(1, containerID(1), 2))
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, a.ReportAnnouncements(&test.DecodeKey(1).PublicKey, anns))
+		require.NoError(t, a.ReportAnnouncements(&test.DecodeKey(2).PublicKey, anns))
+	}
+
+	require.NoError(t, a.Flush())
+	require.Len(t, reqs, 1)
+
+	res := reqs[0].GetBody().GetAnnouncements()
+	require.Len(t, res, 1)
+	require.EqualValues(t, 22, res[0].GetUsedSpace())
+
+	stale := announcement(1, containerID(2), 1)
+
+	ann.SetEpoch(2)
+
+	err := a.ReportAnnouncements(&test.DecodeKey(1).PublicKey, []*container.UsedSpaceAnnouncement{stale, ann})
+	require.True(t, errors.Is(err, announce.ErrStaleEpoch))
+	require.EqualValues(t, 2, a.Epoch())
+
+	require.NoError(t, a.Flush())
+	require.Len(t, reqs, 2)
+	require.Equal(t, []*container.UsedSpaceAnnouncement{ann}, reqs[1].GetBody().GetAnnouncements())
+}
+
+func TestAggregator_HandlerFailure(t *testing.T) {
+	var (
+		reqs  []*container.AnnounceUsedSpaceRequest
+		calls int
+		errH  = errors.New("handler failure")
+	)
+
+	handler := func(req *container.AnnounceUsedSpaceRequest) error {
+		calls++
+		if calls == 2 {
+			return errH
+		}
+
+		reqs = append(reqs, req)
+
+		return nil
+	}
+
+	a := announce.NewAggregator(test.DecodeKey(0), handler,
+		announce.WithMaxSize(maxSizeFor(t, 1)),
+		announce.WithSignOptions(utilsig.SignWithRFC6979()),
+	)
+
+	for i := byte(0); i < 3; i++ {
+		require.NoError(t, a.Report(1, containerID(i), uint64(i)))
+	}
+
+	// second batch fails, the third one is not handled
+	err := a.Report(2, containerID(0), 1)
+	require.True(t, errors.Is(err, errH))
+	require.EqualValues(t, 2, a.Epoch())
+	require.Len(t, reqs, 1)
+
+	require.NoError(t, a.Flush())
+	require.Len(t, reqs, 4)
+
+	for i, req := range reqs {
+		anns := req.GetBody().GetAnnouncements()
+		require.Len(t, anns, 1)
+
+		if i < 3 {
+			require.EqualValues(t, 1, anns[0].GetEpoch())
+			require.Equal(t, containerID(byte(i)), anns[0].GetContainerID())
+		} else {
+			require.EqualValues(t, 2, anns[0].GetEpoch())
+		}
+	}
+}
+
+// maxSizeFor returns maximum request size which fits n single-byte
+// container announcements.
+func maxSizeFor(t *testing.T, n int) int {
+	var req *container.AnnounceUsedSpaceRequest
+
+	a := announce.NewAggregator(test.DecodeKey(0), func(r *container.AnnounceUsedSpaceRequest) error {
+		req = r
+		return nil
+	},
+		announce.WithSignOptions(utilsig.SignWithRFC6979()),
+	)
+
+	for i := 0; i < n; i++ {
+		require.NoError(t, a.Report(1, containerID(byte(i)), 1))
+	}
+
+	require.NoError(t, a.Flush())
+
+	// reserve for the length prefix of the body
+	return req.StableSize() + 2
+}
+
+func TestAggregator_MaxSize(t *testing.T) {
+	var reqs []*container.AnnounceUsedSpaceRequest
+
+	handler := func(req *container.AnnounceUsedSpaceRequest) error {
+		reqs = append(reqs, req)
+		return nil
+	}
+
+	meta := new(session.RequestMetaHeader)
+	meta.SetTTL(1)
+	meta.SetEpoch(1)
+
+	maxSize := maxSizeFor(t, 2)
+
+	a := announce.NewAggregator(test.DecodeKey(0), handler,
+		announce.WithMaxSize(maxSize),
+		announce.WithSignOptions(utilsig.SignWithRFC6979()),
+	)
+
+	for i := byte(0); i < 5; i++ {
+		require.NoError(t, a.Report(1, containerID(i), 1))
+	}
+
+	require.NoError(t, a.Flush())
+	require.Len(t, reqs, 3)
+
+	for _, req := range reqs {
+		require.LessOrEqual(t, req.StableSize(), maxSize)
+	}
+
+	// headers count towards the limit
+	reqs = nil
+
+	a = announce.NewAggregator(test.DecodeKey(0), handler,
+		announce.WithMaxSize(maxSize),
+		announce.WithMetaHeader(meta),
+		announce.WithSignOptions(utilsig.SignWithRFC6979()),
+	)
+
+	for i := byte(0); i < 5; i++ {
+		require.NoError(t, a.Report(1, containerID(i), 1))
+	}
+
+	require.NoError(t, a.Flush())
+	require.Len(t, reqs, 5)
+
+	for _, req := range reqs {
+		require.LessOrEqual(t, req.StableSize(), maxSize)
+	}
+
+	a = announce.NewAggregator(test.DecodeKey(0), handler,
+		announce.WithMaxSize(maxSizeFor(t, 1)-3),
+		announce.WithSignOptions(utilsig.SignWithRFC6979()),
+	)
+
+	require.NoError(t, a.Report(1, containerID(0), 1))
+	require.True(t, errors.Is(a.Flush(), announce.ErrTooBigAnnouncement))
+}
@@ -0,0 +1,93 @@
+package announce
+
+import (
+	"math"
+	"sort"
+)
+
+// Strategy merges used space estimations of the container made by
+// different nodes into a single value.
+//
+// Strategy is called with non-empty list of estimations which it is
+// allowed to reorder.
+type Strategy func([]uint64) uint64
+
+// Sum is a Strategy which returns the sum of the estimations.
+//
+// Sum saturates at math.MaxUint64.
+func Sum(vs []uint64) uint64 {
+	var sum uint64
+
+	for _, v := range vs {
+		if sum > math.MaxUint64-v {
+			return math.MaxUint64
+		}
+
+		sum += v
+	}
+
+	return sum
+}
+
+// Median is a Strategy which returns the median of the estimations.
+//
+// For even number of estimations the mean of two middle values
+// rounded down is returned.
+func Median(vs []uint64) uint64 {
+	sortSizes(vs)
+
+	n := len(vs)
+	if n%2 == 1 {
+		return vs[n/2]
+	}
+
+	return mean(vs[n/2-1 : n/2+1])
+}
+
+// TrimmedMean returns Strategy which discards the ratio of the lowest
+// and the highest estimations and returns the mean of the rest rounded
+// down.
+//
+// Ratio is expected to be in [0, 0.5) range. If no estimations are left,
+// median is returned.
+func TrimmedMean(ratio float64) Strategy {
+	return func(vs []uint64) uint64 {
+		sortSizes(vs)
+
+		k := 0
+		if ratio > 0 {
+			k = int(float64(len(vs)) * ratio)
+		}
+
+		if 2*k >= len(vs) {
+			return Median(vs)
+		}
+
+		return mean(vs[k : len(vs)-k])
+	}
+}
+
+func sortSizes(vs []uint64) {
+	sort.Slice(vs, func(i, j int) bool {
+		return vs[i] < vs[j]
+	})
+}
+
+// mean returns the mean of non-empty list rounded down without overflow.
+func mean(vs []uint64) uint64 {
+	n := uint64(len(vs))
+
+	var q, r uint64
+
+	for _, v := range vs {
+		q += v / n
+		r += v % n
+
+		if r >= n {
+			q++
+			r -= n
+		}
+	}
+
+	return q
+}
@@ -1,5 +1,14 @@
 package container
 
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/internal/attribute"
+	"github.com/pkg/errors"
+)
+
 // SysAttributePrefix is a prefix of key to system attribute.
 const SysAttributePrefix = "__NEOFS__"
 
@@ -7,3 +16,196 @@ const (
 	// SysAttributeSubnet is a string ID of container's storage subnet.
 	SysAttributeSubnet = SysAttributePrefix + "SUBNET"
 )
+
+const (
+	// AttributeName is a key to the human-readable name of the container.
+	AttributeName = "Name"
+
+	// AttributeTimestamp is a key to the container creation time
+	// in Unix seconds.
+	AttributeTimestamp = "Timestamp"
+)
+
+// ErrDuplicateAttribute is returned when container contains several
+// attributes with the same key.
+var ErrDuplicateAttribute = attribute.ErrDuplicate
+
+// ErrInvalidAttribute is returned when container attribute is malformed.
+var ErrInvalidAttribute = attribute.ErrInvalid
+
+// sysAttributes contains known system attributes with their value parsers.
+var sysAttributes = map[string]func(string) error{
+	SysAttributeSubnet: func(v string) error {
+		_, err := ParseSubnetID(v)
+		return err
+	},
+}
+
+// SubnetID is an identifier of the storage subnet.
+//
+// Zero SubnetID is the default subnet.
+type SubnetID uint32
+
+// String returns decimal form of the SubnetID.
+func (id SubnetID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// ParseSubnetID parses SubnetID from the decimal form.
+func ParseSubnetID(s string) (SubnetID, error) {
+	v, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, errors.Wrapf(ErrInvalidAttribute, "invalid subnet ID %q", s)
+	}
+
+	return SubnetID(v), nil
+}
+
+// ValidateAttributes checks that container attributes are well formed.
+//
+// Attribute keys must be unique and non-empty, values must be non-empty.
+// System attributes must be known, values of system attributes and
+// AttributeTimestamp must have correct format.
+//
+// Returns an error wrapping ErrDuplicateAttribute or ErrInvalidAttribute.
+func ValidateAttributes(as []*Attribute) error {
+	keys := make(map[string]struct{}, len(as))
+
+	for i := range as {
+		key, val := as[i].GetKey(), as[i].GetValue()
+
+		switch {
+		case key == "":
+			return errors.Wrapf(ErrInvalidAttribute, "empty key of attribute #%d", i)
+		case val == "":
+			return errors.Wrapf(ErrInvalidAttribute, "empty value of %s", key)
+		}
+
+		if _, ok := keys[key]; ok {
+			return errors.Wrap(ErrDuplicateAttribute, key)
+		}
+
+		keys[key] = struct{}{}
+
+		if key == AttributeTimestamp {
+			if _, err := attribute.ParseTimestamp(AttributeTimestamp, val); err != nil {
+				return err
+			}
+		}
+
+		if !strings.HasPrefix(key, SysAttributePrefix) {
+			continue
+		}
+
+		parse, ok := sysAttributes[key]
+		if !ok {
+			return errors.Wrapf(ErrInvalidAttribute, "unknown system attribute %s", key)
+		}
+
+		if err := parse(val); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// attribute returns the value of the attribute with the key.
+func (c *Container) attribute(key string) (string, bool, error) {
+	as := c.GetAttributes()
+
+	i, err := attribute.Find(len(as), func(i int) string { return as[i].GetKey() }, key)
+	if err != nil || i < 0 {
+		return "", false, err
+	}
+
+	return as[i].GetValue(), true, nil
+}
+
+// setAttribute sets the value of the attribute with the key, or adds
+// new attribute if there is no such key.
+func (c *Container) setAttribute(key, val string) error {
+	if c == nil {
+		return nil
+	}
+
+	i, err := attribute.Find(len(c.attr), func(i int) string { return c.attr[i].GetKey() }, key)
+	if err != nil {
+		return err
+	}
+
+	if i < 0 {
+		a := new(Attribute)
+		a.SetKey(key)
+
+		i = len(c.attr)
+		c.attr = append(c.attr, a)
+	}
+
+	c.attr[i].SetValue(val)
+
+	return nil
+}
+
+// GetName returns the value of AttributeName attribute.
+//
+// Returns empty string if the attribute is not set and an error wrapping
+// ErrDuplicateAttribute if it is set several times.
+func (c *Container) GetName() (string, error) {
+	v, _, err := c.attribute(AttributeName)
+	return v, err
+}
+
+// SetName sets the value of AttributeName attribute.
+//
+// Returns an error wrapping ErrDuplicateAttribute if the attribute
+// is set several times.
+func (c *Container) SetName(v string) error {
+	return c.setAttribute(AttributeName, v)
+}
+
+// GetTimestamp returns the time from AttributeTimestamp attribute.
+//
+// Returns zero time if the attribute is not set, an error wrapping
+// ErrDuplicateAttribute if it is set several times and an error
+// wrapping ErrInvalidAttribute if its value is not a number of
+// Unix seconds.
+func (c *Container) GetTimestamp() (time.Time, error) {
+	v, ok, err := c.attribute(AttributeTimestamp)
+	if err != nil || !ok {
+		return time.Time{}, err
+	}
+
+	return attribute.ParseTimestamp(AttributeTimestamp, v)
+}
+
+// SetTimestamp sets AttributeTimestamp attribute to the time in
+// Unix seconds.
+//
+// Returns an error wrapping ErrDuplicateAttribute if the attribute
+// is set several times.
+func (c *Container) SetTimestamp(v time.Time) error {
+	return c.setAttribute(AttributeTimestamp, attribute.FormatTimestamp(v))
+}
+
+// GetSubnet returns the subnet ID from SysAttributeSubnet attribute.
+//
+// Returns default subnet ID if the attribute is not set, an error
+// wrapping ErrDuplicateAttribute if it is set several times and an
+// error wrapping ErrInvalidAttribute if its value is not a subnet ID.
+func (c *Container) GetSubnet() (SubnetID, error) {
+	v, ok, err := c.attribute(SysAttributeSubnet)
+	if err != nil || !ok {
+		return 0, err
+	}
+
+	return ParseSubnetID(v)
+}
+
+// SetSubnet sets SysAttributeSubnet attribute to the subnet ID.
+//
+// Returns an error wrapping ErrDuplicateAttribute if the attribute
+// is set several times.
+func (c *Container) SetSubnet(v SubnetID) error {
+	return c.setAttribute(SysAttributeSubnet, v.String())
+}
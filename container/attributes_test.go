@@ -0,0 +1,109 @@
+package container_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/container"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainer_Attributes(t *testing.T) {
+	cnr := new(container.Container)
+
+	name, err := cnr.GetName()
+	require.NoError(t, err)
+	require.Empty(t, name)
+
+	subnet, err := cnr.GetSubnet()
+	require.NoError(t, err)
+	require.Zero(t, subnet)
+
+	now := time.Unix(time.Now().Unix(), 0)
+
+	require.NoError(t, cnr.SetName("photos"))
+	require.NoError(t, cnr.SetTimestamp(now))
+	require.NoError(t, cnr.SetSubnet(7))
+	require.NoError(t, cnr.SetName("videos"))
+	require.Len(t, cnr.GetAttributes(), 3)
+
+	name, err = cnr.GetName()
+	require.NoError(t, err)
+	require.Equal(t, "videos", name)
+
+	ts, err := cnr.GetTimestamp()
+	require.NoError(t, err)
+	require.True(t, now.Equal(ts))
+
+	subnet, err = cnr.GetSubnet()
+	require.NoError(t, err)
+	require.EqualValues(t, 7, subnet)
+
+	require.NoError(t, container.ValidateAttributes(cnr.GetAttributes()))
+
+	cnr.SetAttributes(append(cnr.GetAttributes(), generateAttribute(container.AttributeName, "other")))
+
+	_, err = cnr.GetName()
+	require.True(t, errors.Is(err, container.ErrDuplicateAttribute), err)
+	require.True(t, errors.Is(cnr.SetName("x"), container.ErrDuplicateAttribute))
+}
+
+func TestValidateAttributes(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		err   error
+		attrs []*container.Attribute
+	}{
+		{
+			name:  "empty key",
+			err:   container.ErrInvalidAttribute,
+			attrs: []*container.Attribute{generateAttribute("", "value")},
+		},
+		{
+			name:  "empty value",
+			err:   container.ErrInvalidAttribute,
+			attrs: []*container.Attribute{generateAttribute("key", "")},
+		},
+		{
+			name: "duplicate key",
+			err:  container.ErrDuplicateAttribute,
+			attrs: []*container.Attribute{
+				generateAttribute("key", "1"),
+				generateAttribute("key", "2"),
+			},
+		},
+		{
+			name:  "unknown system attribute",
+			err:   container.ErrInvalidAttribute,
+			attrs: []*container.Attribute{generateAttribute(container.SysAttributePrefix+"UNKNOWN", "1")},
+		},
+		{
+			name:  "invalid subnet",
+			err:   container.ErrInvalidAttribute,
+			attrs: []*container.Attribute{generateAttribute(container.SysAttributeSubnet, "-1")},
+		},
+		{
+			name:  "invalid timestamp",
+			err:   container.ErrInvalidAttribute,
+			attrs: []*container.Attribute{generateAttribute(container.AttributeTimestamp, "now")},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := container.ValidateAttributes(tc.attrs)
+			require.True(t, errors.Is(err, tc.err), err)
+		})
+	}
+}
+
+func TestParseSubnetID(t *testing.T) {
+	id, err := container.ParseSubnetID("42")
+	require.NoError(t, err)
+	require.EqualValues(t, 42, id)
+	require.Equal(t, "42", id.String())
+
+	for _, s := range []string{"", "x", "4294967296"} {
+		_, err := container.ParseSubnetID(s)
+		require.True(t, errors.Is(err, container.ErrInvalidAttribute), s)
+	}
+}
@@ -52,7 +52,7 @@ func ContainerToGRPCMessage(c *Container) *container.Container {
 
 	m.SetNonce(c.GetNonce())
 
-	m.SetBasicAcl(c.GetBasicACL())
+	m.SetBasicAcl(uint32(c.GetBasicACL()))
 
 	m.SetPlacementPolicy(
 		netmap.PlacementPolicyToGRPCMessage(c.GetPlacementPolicy()),
@@ -87,7 +87,7 @@ func ContainerFromGRPCMessage(m *container.Container) *Container {
 
 	c.SetNonce(m.GetNonce())
 
-	c.SetBasicACL(m.GetBasicAcl())
+	c.SetBasicACL(acl.BasicACL(m.GetBasicAcl()))
 
 	c.SetPlacementPolicy(
 		netmap.PlacementPolicyFromGRPCMessage(m.GetPlacementPolicy()),
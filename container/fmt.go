@@ -0,0 +1,141 @@
+package container
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/signature"
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/pkg/errors"
+)
+
+// ErrMissingSignature is returned when request body is not signed.
+var ErrMissingSignature = errors.New("missing signature")
+
+// ErrInvalidSignature is returned when signature of the request body
+// does not pass verification.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// ErrOwnerMismatch is returned when the key of the signature does not
+// correspond to the container owner.
+var ErrOwnerMismatch = errors.New("signer is not the container owner")
+
+// CalculateID calculates identifier of the container.
+//
+// Identifier is a SHA256 hash of stable marshaled container.
+func CalculateID(cnr *Container) (*refs.ContainerID, error) {
+	data, err := cnr.StableMarshal(nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal container")
+	}
+
+	sum := sha256.Sum256(data)
+
+	id := new(refs.ContainerID)
+	id.SetValue(sum[:])
+
+	return id, nil
+}
+
+// SignPutRequestBody signs stable marshaled container from the body
+// with the private key of the container owner according to RFC 6979
+// and writes the signature to the body.
+func SignPutRequestBody(key *ecdsa.PrivateKey, body *PutRequestBody) error {
+	sig, err := sign(key, signature.StableMarshalerWrapper{SM: body.GetContainer()})
+	if err != nil {
+		return err
+	}
+
+	body.SetSignature(sig)
+
+	return nil
+}
+
+// VerifyPutRequestBody checks that signature of the body is a valid
+// signature of the container made by its owner.
+//
+// Returns an error wrapping ErrMissingSignature, ErrOwnerMismatch or
+// ErrInvalidSignature on failure.
+func VerifyPutRequestBody(body *PutRequestBody) error {
+	cnr := body.GetContainer()
+	if cnr == nil {
+		return errors.New("missing container")
+	}
+
+	return verify(signature.StableMarshalerWrapper{SM: cnr}, body.GetSignature(), cnr.GetOwnerID())
+}
+
+// SignDeleteRequestBody signs stable marshaled container ID from the body
+// with the private key of the container owner according to RFC 6979
+// and writes the signature to the body.
+func SignDeleteRequestBody(key *ecdsa.PrivateKey, body *DeleteRequestBody) error {
+	sig, err := sign(key, signature.StableMarshalerWrapper{SM: body.GetContainerID()})
+	if err != nil {
+		return err
+	}
+
+	body.SetSignature(sig)
+
+	return nil
+}
+
+// VerifyDeleteRequestBody checks that signature of the body is a valid
+// signature of the container ID made by the owner of the container.
+//
+// Returns an error wrapping ErrMissingSignature, ErrOwnerMismatch or
+// ErrInvalidSignature on failure.
+func VerifyDeleteRequestBody(body *DeleteRequestBody, owner *refs.OwnerID) error {
+	cid := body.GetContainerID()
+	if cid == nil {
+		return errors.New("missing container ID")
+	}
+
+	return verify(signature.StableMarshalerWrapper{SM: cid}, body.GetSignature(), owner)
+}
+
+func sign(key *ecdsa.PrivateKey, src signature.DataSource) (*refs.Signature, error) {
+	sig := new(refs.Signature)
+
+	if err := signature.SignDataWithHandler(
+		key,
+		src,
+		func(key, sign []byte) {
+			sig.SetKey(key)
+			sig.SetSign(sign)
+		},
+		signature.SignWithRFC6979(),
+	); err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+func verify(src signature.DataSource, sig *refs.Signature, owner *refs.OwnerID) error {
+	if len(sig.GetKey()) == 0 || len(sig.GetSign()) == 0 {
+		return ErrMissingSignature
+	}
+
+	pub := crypto.UnmarshalPublicKey(sig.GetKey())
+	if pub == nil {
+		return errors.Wrap(ErrInvalidSignature, "invalid public key")
+	}
+
+	if !bytes.Equal(refs.NewOwnerIDFromPublicKey(pub).GetValue(), owner.GetValue()) {
+		return ErrOwnerMismatch
+	}
+
+	if err := signature.VerifyDataWithSource(
+		src,
+		func() ([]byte, []byte) {
+			return sig.GetKey(), sig.GetSign()
+		},
+		signature.SignWithRFC6979(),
+	); err != nil {
+		return errors.Wrap(ErrInvalidSignature, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package container_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/container"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateID(t *testing.T) {
+	cnr := generateContainer("nonce")
+
+	id, err := container.CalculateID(cnr)
+	require.NoError(t, err)
+
+	data, err := cnr.StableMarshal(nil)
+	require.NoError(t, err)
+
+	sum := sha256.Sum256(data)
+	require.Equal(t, sum[:], id.GetValue())
+}
+
+func TestPutRequestBodySignature(t *testing.T) {
+	key := test.DecodeKey(0)
+
+	cnr := generateContainer("nonce")
+	cnr.SetOwnerID(refs.NewOwnerIDFromPublicKey(&key.PublicKey))
+
+	body := new(container.PutRequestBody)
+	body.SetContainer(cnr)
+
+	require.Equal(t, container.ErrMissingSignature, container.VerifyPutRequestBody(body))
+
+	require.NoError(t, container.SignPutRequestBody(key, body))
+	require.NoError(t, container.VerifyPutRequestBody(body))
+
+	t.Run("foreign key", func(t *testing.T) {
+		other := new(container.PutRequestBody)
+		other.SetContainer(cnr)
+		require.NoError(t, container.SignPutRequestBody(test.DecodeKey(1), other))
+
+		require.Equal(t, container.ErrOwnerMismatch, container.VerifyPutRequestBody(other))
+	})
+
+	t.Run("modified container", func(t *testing.T) {
+		cnr.SetBasicACL(cnr.GetBasicACL() + 1)
+		defer cnr.SetBasicACL(cnr.GetBasicACL() - 1)
+
+		err := container.VerifyPutRequestBody(body)
+		require.True(t, errors.Is(err, container.ErrInvalidSignature), err)
+	})
+}
+
+func TestDeleteRequestBodySignature(t *testing.T) {
+	key := test.DecodeKey(0)
+	owner := refs.NewOwnerIDFromPublicKey(&key.PublicKey)
+
+	body := generateDeleteRequestBody("id")
+
+	require.NoError(t, container.SignDeleteRequestBody(key, body))
+	require.NoError(t, container.VerifyDeleteRequestBody(body, owner))
+
+	foreign := refs.NewOwnerIDFromPublicKey(&test.DecodeKey(1).PublicKey)
+	require.Equal(t, container.ErrOwnerMismatch, container.VerifyDeleteRequestBody(body, foreign))
+
+	body.SetContainerID(generateDeleteRequestBody("other").GetContainerID())
+
+	err := container.VerifyDeleteRequestBody(body, owner)
+	require.True(t, errors.Is(err, container.ErrInvalidSignature), err)
+}
@@ -1,9 +1,10 @@
 package container
 
 import (
-	container "github.com/cthulhu-rider/neofs-api-go/v2/container/grpc"
+	"github.com/cthulhu-rider/neofs-api-go/v2/acl"
+	"github.com/cthulhu-rider/neofs-api-go/v2/netmap"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
 	protoutil "github.com/cthulhu-rider/neofs-api-go/v2/util/proto"
-	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -46,6 +47,10 @@ const (
 	usedSpaceAnnounceUsedSpaceField = 3
 
 	usedSpaceReqBodyAnnouncementsField = 1
+
+	usedSpaceReqBodyField         = 1
+	usedSpaceReqMetaHeaderField   = 2
+	usedSpaceReqVerifyHeaderField = 3
 )
 
 func (a *Attribute) StableMarshal(buf []byte) ([]byte, error) {
@@ -88,15 +93,27 @@ func (a *Attribute) StableSize() (size int) {
 	return size
 }
 
-func (a *Attribute) Unmarshal(data []byte) error {
-	m := new(container.Container_Attribute)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
+func (a *Attribute) StableUnmarshal(data []byte) error {
+	if a == nil {
+		return nil
 	}
 
-	*a = *AttributeFromGRPCMessage(m)
+	*a = Attribute{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case attributeKeyField:
+			a.key, err = f.StringValue()
+		case attributeValueField:
+			a.val, err = f.StringValue()
+		}
+
+		return
+	})
+}
 
-	return nil
+func (a *Attribute) Unmarshal(data []byte) error {
+	return a.StableUnmarshal(data)
 }
 
 func (c *Container) StableMarshal(buf []byte) ([]byte, error) {
@@ -134,7 +151,7 @@ func (c *Container) StableMarshal(buf []byte) ([]byte, error) {
 
 	offset += n
 
-	n, err = protoutil.UInt32Marshal(containerBasicACLField, buf[offset:], c.basicACL)
+	n, err = protoutil.UInt32Marshal(containerBasicACLField, buf[offset:], uint32(c.basicACL))
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +183,7 @@ func (c *Container) StableSize() (size int) {
 	size += protoutil.NestedStructureSize(containerVersionField, c.version)
 	size += protoutil.NestedStructureSize(containerOwnerField, c.ownerID)
 	size += protoutil.BytesSize(containerNonceField, c.nonce)
-	size += protoutil.UInt32Size(containerBasicACLField, c.basicACL)
+	size += protoutil.UInt32Size(containerBasicACLField, uint32(c.basicACL))
 
 	for i := range c.attr {
 		size += protoutil.NestedStructureSize(containerAttributesField, c.attr[i])
@@ -177,15 +194,43 @@ func (c *Container) StableSize() (size int) {
 	return size
 }
 
-func (c *Container) Unmarshal(data []byte) error {
-	m := new(container.Container)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
-	}
+func (c *Container) StableUnmarshal(data []byte) error {
+	if c == nil {
+		return nil
+	}
+
+	*c = Container{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case containerVersionField:
+			c.version = new(refs.Version)
+			err = f.Nested(c.version)
+		case containerOwnerField:
+			c.ownerID = new(refs.OwnerID)
+			err = f.Nested(c.ownerID)
+		case containerNonceField:
+			c.nonce, err = f.Bytes()
+		case containerBasicACLField:
+			var v uint32
+			v, err = f.UInt32()
+			c.basicACL = acl.BasicACL(v)
+		case containerAttributesField:
+			v := new(Attribute)
+			c.attr = append(c.attr, v)
+
+			err = f.Nested(v)
+		case containerPlacementField:
+			c.policy = new(netmap.PlacementPolicy)
+			err = f.Nested(c.policy)
+		}
 
-	*c = *ContainerFromGRPCMessage(m)
+		return
+	})
+}
 
-	return nil
+func (c *Container) Unmarshal(data []byte) error {
+	return c.StableUnmarshal(data)
 }
 
 func (r *PutRequestBody) StableMarshal(buf []byte) ([]byte, error) {
@@ -228,6 +273,27 @@ func (r *PutRequestBody) StableSize() (size int) {
 	return size
 }
 
+func (r *PutRequestBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = PutRequestBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case putReqBodyContainerField:
+			r.cnr = new(Container)
+			err = f.Nested(r.cnr)
+		case putReqBodySignatureField:
+			r.sig = new(refs.Signature)
+			err = f.Nested(r.sig)
+		}
+
+		return
+	})
+}
+
 func (r *PutResponseBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -259,6 +325,24 @@ func (r *PutResponseBody) StableSize() (size int) {
 	return size
 }
 
+func (r *PutResponseBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = PutResponseBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case putRespBodyIDField:
+			r.cid = new(refs.ContainerID)
+			err = f.Nested(r.cid)
+		}
+
+		return
+	})
+}
+
 func (r *DeleteRequestBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -299,6 +383,27 @@ func (r *DeleteRequestBody) StableSize() (size int) {
 	return size
 }
 
+func (r *DeleteRequestBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = DeleteRequestBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case deleteReqBodyIDField:
+			r.cid = new(refs.ContainerID)
+			err = f.Nested(r.cid)
+		case deleteReqBodySignatureField:
+			r.sig = new(refs.Signature)
+			err = f.Nested(r.sig)
+		}
+
+		return
+	})
+}
+
 func (r *DeleteResponseBody) StableMarshal(buf []byte) ([]byte, error) {
 	return nil, nil
 }
@@ -307,6 +412,22 @@ func (r *DeleteResponseBody) StableSize() (size int) {
 	return 0
 }
 
+func (r *DeleteResponseBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = DeleteResponseBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+
+		}
+
+		return
+	})
+}
+
 func (r *GetRequestBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -334,6 +455,24 @@ func (r *GetRequestBody) StableSize() (size int) {
 	return size
 }
 
+func (r *GetRequestBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = GetRequestBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case getReqBodyIDField:
+			r.cid = new(refs.ContainerID)
+			err = f.Nested(r.cid)
+		}
+
+		return
+	})
+}
+
 func (r *GetResponseBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -361,6 +500,24 @@ func (r *GetResponseBody) StableSize() (size int) {
 	return size
 }
 
+func (r *GetResponseBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = GetResponseBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case getRespBodyContainerField:
+			r.cnr = new(Container)
+			err = f.Nested(r.cnr)
+		}
+
+		return
+	})
+}
+
 func (r *ListRequestBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -388,6 +545,24 @@ func (r *ListRequestBody) StableSize() (size int) {
 	return size
 }
 
+func (r *ListRequestBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = ListRequestBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case listReqBodyOwnerField:
+			r.ownerID = new(refs.OwnerID)
+			err = f.Nested(r.ownerID)
+		}
+
+		return
+	})
+}
+
 func (r *ListResponseBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -426,6 +601,26 @@ func (r *ListResponseBody) StableSize() (size int) {
 	return size
 }
 
+func (r *ListResponseBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = ListResponseBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case listRespBodyIDsField:
+			v := new(refs.ContainerID)
+			r.cidList = append(r.cidList, v)
+
+			err = f.Nested(v)
+		}
+
+		return
+	})
+}
+
 func (r *SetExtendedACLRequestBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -466,6 +661,27 @@ func (r *SetExtendedACLRequestBody) StableSize() (size int) {
 	return size
 }
 
+func (r *SetExtendedACLRequestBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = SetExtendedACLRequestBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case setEACLReqBodyTableField:
+			r.eacl = new(acl.Table)
+			err = f.Nested(r.eacl)
+		case setEACLReqBodySignatureField:
+			r.sig = new(refs.Signature)
+			err = f.Nested(r.sig)
+		}
+
+		return
+	})
+}
+
 func (r *SetExtendedACLResponseBody) StableMarshal(buf []byte) ([]byte, error) {
 	return nil, nil
 }
@@ -474,6 +690,22 @@ func (r *SetExtendedACLResponseBody) StableSize() (size int) {
 	return 0
 }
 
+func (r *SetExtendedACLResponseBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = SetExtendedACLResponseBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+
+		}
+
+		return
+	})
+}
+
 func (r *GetExtendedACLRequestBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -501,6 +733,24 @@ func (r *GetExtendedACLRequestBody) StableSize() (size int) {
 	return size
 }
 
+func (r *GetExtendedACLRequestBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = GetExtendedACLRequestBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case getEACLReqBodyIDField:
+			r.cid = new(refs.ContainerID)
+			err = f.Nested(r.cid)
+		}
+
+		return
+	})
+}
+
 func (r *GetExtendedACLResponseBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -541,6 +791,27 @@ func (r *GetExtendedACLResponseBody) StableSize() (size int) {
 	return size
 }
 
+func (r *GetExtendedACLResponseBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = GetExtendedACLResponseBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case getEACLRespBodyTableField:
+			r.eacl = new(acl.Table)
+			err = f.Nested(r.eacl)
+		case getEACLRespBodySignatureField:
+			r.sig = new(refs.Signature)
+			err = f.Nested(r.sig)
+		}
+
+		return
+	})
+}
+
 func (a *UsedSpaceAnnouncement) StableMarshal(buf []byte) ([]byte, error) {
 	if a == nil {
 		return []byte{}, nil
@@ -589,15 +860,30 @@ func (a *UsedSpaceAnnouncement) StableSize() (size int) {
 	return size
 }
 
-func (a *UsedSpaceAnnouncement) Unmarshal(data []byte) error {
-	m := new(container.AnnounceUsedSpaceRequest_Body_Announcement)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
+func (a *UsedSpaceAnnouncement) StableUnmarshal(data []byte) error {
+	if a == nil {
+		return nil
 	}
 
-	*a = *UsedSpaceAnnouncementFromGRPCMessage(m)
+	*a = UsedSpaceAnnouncement{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case usedSpaceAnnounceEpochField:
+			a.epoch, err = f.UInt64()
+		case usedSpaceAnnounceCIDField:
+			a.cid = new(refs.ContainerID)
+			err = f.Nested(a.cid)
+		case usedSpaceAnnounceUsedSpaceField:
+			a.usedSpace, err = f.UInt64()
+		}
+
+		return
+	})
+}
 
-	return nil
+func (a *UsedSpaceAnnouncement) Unmarshal(data []byte) error {
+	return a.StableUnmarshal(data)
 }
 
 func (r *AnnounceUsedSpaceRequestBody) StableMarshal(buf []byte) ([]byte, error) {
@@ -638,6 +924,38 @@ func (r *AnnounceUsedSpaceRequestBody) StableSize() (size int) {
 	return size
 }
 
+func (r *AnnounceUsedSpaceRequestBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = AnnounceUsedSpaceRequestBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case usedSpaceReqBodyAnnouncementsField:
+			v := new(UsedSpaceAnnouncement)
+			r.announcements = append(r.announcements, v)
+
+			err = f.Nested(v)
+		}
+
+		return
+	})
+}
+
+func (r *AnnounceUsedSpaceRequest) StableSize() (size int) {
+	if r == nil {
+		return 0
+	}
+
+	size += protoutil.NestedStructureSize(usedSpaceReqBodyField, r.body)
+	size += protoutil.NestedStructureSize(usedSpaceReqMetaHeaderField, r.metaHeader)
+	size += protoutil.NestedStructureSize(usedSpaceReqVerifyHeaderField, r.verifyHeader)
+
+	return size
+}
+
 func (r *AnnounceUsedSpaceResponseBody) StableMarshal(buf []byte) ([]byte, error) {
 	return nil, nil
 }
@@ -645,3 +963,19 @@ func (r *AnnounceUsedSpaceResponseBody) StableMarshal(buf []byte) ([]byte, error
 func (r *AnnounceUsedSpaceResponseBody) StableSize() (size int) {
 	return 0
 }
+
+func (r *AnnounceUsedSpaceResponseBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = AnnounceUsedSpaceResponseBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+
+		}
+
+		return
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cthulhu-rider/neofs-api-go/v2/container"
 	grpc "github.com/cthulhu-rider/neofs-api-go/v2/container/grpc"
 	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/session"
 	"github.com/stretchr/testify/require"
 	goproto "google.golang.org/protobuf/proto"
 )
@@ -247,6 +248,10 @@ func TestUsedSpaceAnnouncement_StableMarshal(t *testing.T) {
 
 		to := container.UsedSpaceAnnouncementFromGRPCMessage(transport)
 		require.Equal(t, from, to)
+
+		to = new(container.UsedSpaceAnnouncement)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, from, to)
 	})
 }
 
@@ -266,6 +271,27 @@ func TestAnnounceUsedSpaceRequestBody_StableMarshal(t *testing.T) {
 	})
 }
 
+func TestAnnounceUsedSpaceRequest_StableSize(t *testing.T) {
+	meta := new(session.RequestMetaHeader)
+	meta.SetTTL(1)
+	meta.SetEpoch(13)
+
+	verify := new(session.RequestVerificationHeader)
+	verify.SetBodySignature(generateSignature("public key", "body signature"))
+	verify.SetMetaSignature(generateSignature("public key", "meta signature"))
+	verify.SetOriginSignature(generateSignature("public key", "origin signature"))
+
+	req := new(container.AnnounceUsedSpaceRequest)
+	require.Zero(t, req.StableSize())
+
+	req.SetBody(generateAnnounceRequestBody(10))
+	req.SetMetaHeader(meta)
+	req.SetVerificationHeader(verify)
+
+	transport := container.AnnounceUsedSpaceRequestToGRPCMessage(req)
+	require.Equal(t, goproto.Size(transport), req.StableSize())
+}
+
 func TestAnnounceUsedSpaceResponseBody_StableMarshal(t *testing.T) {
 	responseFrom := generateAnnounceResponseBody()
 	transport := new(grpc.AnnounceUsedSpaceResponse_Body)
@@ -0,0 +1,127 @@
+package container
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/acl"
+	"github.com/cthulhu-rider/neofs-api-go/v2/internal/uuid"
+	"github.com/cthulhu-rider/neofs-api-go/v2/netmap"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/pkg/errors"
+)
+
+// ContainerOption is a NewContainer configuration option.
+type ContainerOption func(*containerCfg)
+
+type containerCfg struct {
+	version *refs.Version
+
+	nonce []byte
+
+	owner *refs.OwnerID
+
+	basicACL acl.BasicACL
+
+	attrs []*Attribute
+
+	policy *netmap.PlacementPolicy
+}
+
+// NonceSize is a size of the container nonce in bytes.
+const NonceSize = uuid.Size
+
+func defaultContainerCfg() *containerCfg {
+	return &containerCfg{
+		version:  refs.CurrentVersion(),
+		basicACL: acl.BasicACLPrivate,
+	}
+}
+
+// NewContainer creates new Container.
+//
+// By default, container has current API version, random UUIDv4 nonce
+// and acl.BasicACLPrivate basic ACL. Owner and placement policy should
+// be set with options in order to make the container valid according
+// to ValidateContainer.
+func NewContainer(opts ...ContainerOption) (*Container, error) {
+	c := defaultContainerCfg()
+
+	for i := range opts {
+		opts[i](c)
+	}
+
+	if c.nonce == nil {
+		nonce, err := uuid.NewV4()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate nonce")
+		}
+
+		c.nonce = nonce
+	}
+
+	cnr := new(Container)
+	cnr.SetVersion(c.version)
+	cnr.SetNonce(c.nonce)
+	cnr.SetOwnerID(c.owner)
+	cnr.SetBasicACL(c.basicACL)
+	cnr.SetAttributes(c.attrs)
+	cnr.SetPlacementPolicy(c.policy)
+
+	return cnr, nil
+}
+
+// WithVersion returns option to set API version of the container.
+func WithVersion(v *refs.Version) ContainerOption {
+	return func(c *containerCfg) {
+		c.version = v
+	}
+}
+
+// WithNonce returns option to set nonce of the container
+// instead of the random one.
+func WithNonce(v []byte) ContainerOption {
+	return func(c *containerCfg) {
+		c.nonce = v
+	}
+}
+
+// WithOwnerID returns option to set owner of the container.
+func WithOwnerID(v *refs.OwnerID) ContainerOption {
+	return func(c *containerCfg) {
+		c.owner = v
+	}
+}
+
+// WithOwnerPublicKey returns option to set owner of the container
+// to the owner of the public key.
+func WithOwnerPublicKey(v *ecdsa.PublicKey) ContainerOption {
+	return func(c *containerCfg) {
+		c.owner = refs.NewOwnerIDFromPublicKey(v)
+	}
+}
+
+// WithBasicACL returns option to set basic ACL of the container.
+func WithBasicACL(v acl.BasicACL) ContainerOption {
+	return func(c *containerCfg) {
+		c.basicACL = v
+	}
+}
+
+// WithAttribute returns option to add attribute to the container.
+func WithAttribute(key, val string) ContainerOption {
+	return func(c *containerCfg) {
+		a := new(Attribute)
+		a.SetKey(key)
+		a.SetValue(val)
+
+		c.attrs = append(c.attrs, a)
+	}
+}
+
+// WithPlacementPolicy returns option to set placement policy
+// of the container.
+func WithPlacementPolicy(v *netmap.PlacementPolicy) ContainerOption {
+	return func(c *containerCfg) {
+		c.policy = v
+	}
+}
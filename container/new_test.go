@@ -0,0 +1,146 @@
+package container_test
+
+import (
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/acl"
+	"github.com/cthulhu-rider/neofs-api-go/v2/container"
+	"github.com/cthulhu-rider/neofs-api-go/v2/netmap"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func testPolicy() *netmap.PlacementPolicy {
+	r := new(netmap.Replica)
+	r.SetCount(1)
+
+	p := new(netmap.PlacementPolicy)
+	p.SetReplicas([]*netmap.Replica{r})
+
+	return p
+}
+
+func TestNewContainer(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		cnr, err := container.NewContainer()
+		require.NoError(t, err)
+
+		require.Equal(t, refs.CurrentVersion(), cnr.GetVersion())
+		require.Equal(t, acl.BasicACLPrivate, cnr.GetBasicACL())
+
+		nonce := cnr.GetNonce()
+		require.Len(t, nonce, container.NonceSize)
+		require.EqualValues(t, 0x40, nonce[6]&0xf0)
+		require.EqualValues(t, 0x80, nonce[8]&0xc0)
+
+		other, err := container.NewContainer()
+		require.NoError(t, err)
+		require.NotEqual(t, nonce, other.GetNonce())
+
+		require.True(t, errors.Is(container.ValidateContainer(cnr), container.ErrMissingField))
+	})
+
+	t.Run("options", func(t *testing.T) {
+		key := test.DecodeKey(0)
+		policy := testPolicy()
+		nonce := make([]byte, container.NonceSize)
+
+		cnr, err := container.NewContainer(
+			container.WithOwnerPublicKey(&key.PublicKey),
+			container.WithNonce(nonce),
+			container.WithBasicACL(acl.BasicACLPublicRead),
+			container.WithAttribute(container.AttributeName, "photos"),
+			container.WithPlacementPolicy(policy),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, refs.NewOwnerIDFromPublicKey(&key.PublicKey), cnr.GetOwnerID())
+		require.Equal(t, nonce, cnr.GetNonce())
+		require.Equal(t, acl.BasicACLPublicRead, cnr.GetBasicACL())
+		require.Equal(t, policy, cnr.GetPlacementPolicy())
+
+		name, err := cnr.GetName()
+		require.NoError(t, err)
+		require.Equal(t, "photos", name)
+
+		require.NoError(t, container.ValidateContainer(cnr))
+	})
+}
+
+func TestValidateContainer(t *testing.T) {
+	valid := func() *container.Container {
+		cnr, err := container.NewContainer(
+			container.WithOwnerPublicKey(&test.DecodeKey(0).PublicKey),
+			container.WithPlacementPolicy(testPolicy()),
+		)
+		require.NoError(t, err)
+
+		return cnr
+	}
+
+	require.NoError(t, container.ValidateContainer(valid()))
+
+	for _, tc := range []struct {
+		name   string
+		err    error
+		modify func(*container.Container)
+	}{
+		{
+			name:   "missing version",
+			err:    container.ErrMissingField,
+			modify: func(c *container.Container) { c.SetVersion(nil) },
+		},
+		{
+			name:   "missing owner",
+			err:    container.ErrMissingField,
+			modify: func(c *container.Container) { c.SetOwnerID(nil) },
+		},
+		{
+			name:   "short owner",
+			err:    container.ErrInvalidOwner,
+			modify: func(c *container.Container) { c.GetOwnerID().SetValue([]byte{1}) },
+		},
+		{
+			name:   "missing nonce",
+			err:    container.ErrMissingField,
+			modify: func(c *container.Container) { c.SetNonce(nil) },
+		},
+		{
+			name:   "short nonce",
+			err:    container.ErrInvalidNonce,
+			modify: func(c *container.Container) { c.SetNonce([]byte{1, 2, 3}) },
+		},
+		{
+			name:   "missing placement policy",
+			err:    container.ErrMissingField,
+			modify: func(c *container.Container) { c.SetPlacementPolicy(nil) },
+		},
+		{
+			name: "invalid attribute",
+			err:  container.ErrInvalidAttribute,
+			modify: func(c *container.Container) {
+				c.SetAttributes([]*container.Attribute{generateAttribute("key", "")})
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cnr := valid()
+			tc.modify(cnr)
+
+			err := container.ValidateContainer(cnr)
+			require.True(t, errors.Is(err, tc.err), err)
+		})
+	}
+
+	t.Run("invalid placement policy", func(t *testing.T) {
+		cnr := valid()
+		cnr.GetPlacementPolicy().GetReplicas()[0].SetSelector("X")
+
+		var e *netmap.PolicyValidationError
+		require.True(t, errors.As(container.ValidateContainer(cnr), &e))
+		require.Len(t, e.Errors, 1)
+		require.Equal(t, "replicas[0].selector", e.Errors[0].Path)
+	})
+}
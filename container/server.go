@@ -0,0 +1,89 @@
+package container
+
+import (
+	"context"
+
+	container "github.com/cthulhu-rider/neofs-api-go/v2/container/grpc"
+	"google.golang.org/grpc"
+)
+
+type grpcServer struct {
+	svc Service
+}
+
+// NewGRPCServer returns gRPC server of the container service
+// which handles requests with svc.
+func NewGRPCServer(svc Service) container.ContainerServiceServer {
+	return &grpcServer{
+		svc: svc,
+	}
+}
+
+// RegisterGRPCServer registers gRPC server of the container service
+// which handles requests with svc on gs.
+func RegisterGRPCServer(gs *grpc.Server, svc Service) {
+	container.RegisterContainerServiceServer(gs, NewGRPCServer(svc))
+}
+
+func (s *grpcServer) Put(ctx context.Context, req *container.PutRequest) (*container.PutResponse, error) {
+	resp, err := s.svc.Put(ctx, PutRequestFromGRPCMessage(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return PutResponseToGRPCMessage(resp), nil
+}
+
+func (s *grpcServer) Delete(ctx context.Context, req *container.DeleteRequest) (*container.DeleteResponse, error) {
+	resp, err := s.svc.Delete(ctx, DeleteRequestFromGRPCMessage(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return DeleteResponseToGRPCMessage(resp), nil
+}
+
+func (s *grpcServer) Get(ctx context.Context, req *container.GetRequest) (*container.GetResponse, error) {
+	resp, err := s.svc.Get(ctx, GetRequestFromGRPCMessage(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return GetResponseToGRPCMessage(resp), nil
+}
+
+func (s *grpcServer) List(ctx context.Context, req *container.ListRequest) (*container.ListResponse, error) {
+	resp, err := s.svc.List(ctx, ListRequestFromGRPCMessage(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return ListResponseToGRPCMessage(resp), nil
+}
+
+func (s *grpcServer) SetExtendedACL(ctx context.Context, req *container.SetExtendedACLRequest) (*container.SetExtendedACLResponse, error) {
+	resp, err := s.svc.SetExtendedACL(ctx, SetExtendedACLRequestFromGRPCMessage(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return SetExtendedACLResponseToGRPCMessage(resp), nil
+}
+
+func (s *grpcServer) GetExtendedACL(ctx context.Context, req *container.GetExtendedACLRequest) (*container.GetExtendedACLResponse, error) {
+	resp, err := s.svc.GetExtendedACL(ctx, GetExtendedACLRequestFromGRPCMessage(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return GetExtendedACLResponseToGRPCMessage(resp), nil
+}
+
+func (s *grpcServer) AnnounceUsedSpace(ctx context.Context, req *container.AnnounceUsedSpaceRequest) (*container.AnnounceUsedSpaceResponse, error) {
+	resp, err := s.svc.AnnounceUsedSpace(ctx, AnnounceUsedSpaceRequestFromGRPCMessage(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return AnnounceUsedSpaceResponseToGRPCMessage(resp), nil
+}
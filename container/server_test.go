@@ -0,0 +1,184 @@
+package container_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/client"
+	"github.com/cthulhu-rider/neofs-api-go/v2/container"
+	"github.com/cthulhu-rider/neofs-api-go/v2/internal/grpctest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testService records request bodies and responds with test fixtures.
+type testService struct {
+	err error
+
+	body interface{}
+}
+
+func (s *testService) Put(_ context.Context, req *container.PutRequest) (*container.PutResponse, error) {
+	s.body = req.GetBody()
+
+	resp := new(container.PutResponse)
+	resp.SetBody(generatePutResponseBody("container"))
+
+	return resp, s.err
+}
+
+func (s *testService) Delete(_ context.Context, req *container.DeleteRequest) (*container.DeleteResponse, error) {
+	s.body = req.GetBody()
+
+	resp := new(container.DeleteResponse)
+	resp.SetBody(generateDeleteResponseBody())
+
+	return resp, s.err
+}
+
+func (s *testService) Get(_ context.Context, req *container.GetRequest) (*container.GetResponse, error) {
+	s.body = req.GetBody()
+
+	resp := new(container.GetResponse)
+	resp.SetBody(generateGetResponseBody("container"))
+
+	return resp, s.err
+}
+
+func (s *testService) List(_ context.Context, req *container.ListRequest) (*container.ListResponse, error) {
+	s.body = req.GetBody()
+
+	resp := new(container.ListResponse)
+	resp.SetBody(generateListResponseBody(3))
+
+	return resp, s.err
+}
+
+func (s *testService) SetExtendedACL(_ context.Context, req *container.SetExtendedACLRequest) (*container.SetExtendedACLResponse, error) {
+	s.body = req.GetBody()
+
+	resp := new(container.SetExtendedACLResponse)
+	resp.SetBody(generateSetEACLResponseBody())
+
+	return resp, s.err
+}
+
+func (s *testService) GetExtendedACL(_ context.Context, req *container.GetExtendedACLRequest) (*container.GetExtendedACLResponse, error) {
+	s.body = req.GetBody()
+
+	resp := new(container.GetExtendedACLResponse)
+	resp.SetBody(generateGetEACLResponseBody(3, "key", "value"))
+
+	return resp, s.err
+}
+
+func (s *testService) AnnounceUsedSpace(_ context.Context, req *container.AnnounceUsedSpaceRequest) (*container.AnnounceUsedSpaceResponse, error) {
+	s.body = req.GetBody()
+
+	resp := new(container.AnnounceUsedSpaceResponse)
+	resp.SetBody(generateAnnounceResponseBody())
+
+	return resp, s.err
+}
+
+func TestGRPCServer(t *testing.T) {
+	svc := new(testService)
+
+	conn := grpctest.Dial(t, func(gs *grpc.Server) {
+		container.RegisterGRPCServer(gs, svc)
+	})
+
+	c, err := container.NewClient(container.WithGlobalOpts(client.WithGRPCConn(conn)))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("put", func(t *testing.T) {
+		req := new(container.PutRequest)
+		req.SetBody(generatePutRequestBody("container"))
+
+		resp, err := c.Put(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, req.GetBody(), svc.body)
+		require.Equal(t, generatePutResponseBody("container"), resp.GetBody())
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		req := new(container.DeleteRequest)
+		req.SetBody(generateDeleteRequestBody("container"))
+
+		resp, err := c.Delete(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, req.GetBody(), svc.body)
+		require.Equal(t, generateDeleteResponseBody(), resp.GetBody())
+	})
+
+	t.Run("get", func(t *testing.T) {
+		req := new(container.GetRequest)
+		req.SetBody(generateGetRequestBody("container"))
+
+		resp, err := c.Get(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, req.GetBody(), svc.body)
+		require.Equal(t, generateGetResponseBody("container"), resp.GetBody())
+	})
+
+	t.Run("list", func(t *testing.T) {
+		req := new(container.ListRequest)
+		req.SetBody(generateListRequestBody("owner"))
+
+		resp, err := c.List(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, req.GetBody(), svc.body)
+		require.Equal(t, generateListResponseBody(3), resp.GetBody())
+	})
+
+	t.Run("set extended ACL", func(t *testing.T) {
+		req := new(container.SetExtendedACLRequest)
+		req.SetBody(generateSetEACLRequestBody(3, "key", "value"))
+
+		resp, err := c.SetExtendedACL(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, req.GetBody(), svc.body)
+		require.Equal(t, generateSetEACLResponseBody(), resp.GetBody())
+	})
+
+	t.Run("get extended ACL", func(t *testing.T) {
+		req := new(container.GetExtendedACLRequest)
+		req.SetBody(generateGetEACLRequestBody("container"))
+
+		resp, err := c.GetExtendedACL(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, req.GetBody(), svc.body)
+		require.Equal(t, generateGetEACLResponseBody(3, "key", "value"), resp.GetBody())
+	})
+
+	t.Run("announce used space", func(t *testing.T) {
+		req := new(container.AnnounceUsedSpaceRequest)
+		req.SetBody(generateAnnounceRequestBody(3))
+
+		resp, err := c.AnnounceUsedSpace(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, req.GetBody(), svc.body)
+		require.Equal(t, generateAnnounceResponseBody(), resp.GetBody())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		svc.err = status.Error(codes.PermissionDenied, "access denied")
+		defer func() { svc.err = nil }()
+
+		req := new(container.GetRequest)
+		req.SetBody(generateGetRequestBody("container"))
+
+		_, err := c.Get(ctx, req)
+		require.Error(t, err)
+
+		st, ok := status.FromError(errors.Cause(err))
+		require.True(t, ok)
+		require.Equal(t, codes.PermissionDenied, st.Code())
+		require.Equal(t, "access denied", st.Message())
+	})
+}
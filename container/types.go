@@ -18,7 +18,7 @@ type Container struct {
 
 	nonce []byte
 
-	basicACL uint32
+	basicACL acl.BasicACL
 
 	attr []*Attribute
 
@@ -177,7 +177,7 @@ func (c *Container) SetNonce(v []byte) {
 	}
 }
 
-func (c *Container) GetBasicACL() uint32 {
+func (c *Container) GetBasicACL() acl.BasicACL {
 	if c != nil {
 		return c.basicACL
 	}
@@ -185,7 +185,7 @@ func (c *Container) GetBasicACL() uint32 {
 	return 0
 }
 
-func (c *Container) SetBasicACL(v uint32) {
+func (c *Container) SetBasicACL(v acl.BasicACL) {
 	if c != nil {
 		c.basicACL = v
 	}
@@ -0,0 +1,71 @@
+package container
+
+import (
+	"github.com/cthulhu-rider/neofs-api-go/v2/netmap"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/pkg/errors"
+)
+
+// ErrMissingField is returned when required field of the container
+// is not set.
+var ErrMissingField = errors.New("missing required field")
+
+// ErrInvalidNonce is returned when container nonce has incorrect length.
+var ErrInvalidNonce = errors.New("invalid nonce")
+
+// ErrInvalidOwner is returned when container owner ID has incorrect length.
+var ErrInvalidOwner = errors.New("invalid owner ID")
+
+// ValidateContainer checks that container is well formed.
+//
+// Version, owner ID, nonce and placement policy are required. Owner ID
+// must be a NEO3 wallet address, nonce must be NonceSize bytes long.
+// Placement policy must pass ValidatePlacementPolicy, attributes must
+// pass ValidateAttributes.
+//
+// Returns an error wrapping one of the package errors or
+// *netmap.PolicyValidationError.
+func ValidateContainer(cnr *Container) error {
+	if cnr == nil {
+		return errors.Wrap(ErrMissingField, "container")
+	}
+
+	if cnr.GetVersion() == nil {
+		return errors.Wrap(ErrMissingField, "version")
+	}
+
+	switch owner := cnr.GetOwnerID().GetValue(); len(owner) {
+	case 0:
+		return errors.Wrap(ErrMissingField, "owner ID")
+	case refs.OwnerIDSize:
+	default:
+		return errors.Wrapf(ErrInvalidOwner, "expected length %d, got %d", refs.OwnerIDSize, len(owner))
+	}
+
+	switch nonce := cnr.GetNonce(); len(nonce) {
+	case 0:
+		return errors.Wrap(ErrMissingField, "nonce")
+	case NonceSize:
+	default:
+		return errors.Wrapf(ErrInvalidNonce, "expected length %d, got %d", NonceSize, len(nonce))
+	}
+
+	if cnr.GetPlacementPolicy() == nil {
+		return errors.Wrap(ErrMissingField, "placement policy")
+	}
+
+	if err := ValidatePlacementPolicy(cnr.GetPlacementPolicy()); err != nil {
+		return err
+	}
+
+	return ValidateAttributes(cnr.GetAttributes())
+}
+
+// ValidatePlacementPolicy checks that placement policy of the container
+// is well formed, so that malformed policies are rejected before the
+// container is sent to the network.
+//
+// Returns *netmap.PolicyValidationError describing all found problems.
+func ValidatePlacementPolicy(p *netmap.PlacementPolicy) error {
+	return netmap.ValidatePlacementPolicy(p)
+}
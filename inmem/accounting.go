@@ -0,0 +1,30 @@
+package inmem
+
+import (
+	"context"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/accounting"
+)
+
+type accountingService Node
+
+// balancePrecision is a precision of the balances returned by the node.
+const balancePrecision = 12
+
+func (s *accountingService) Balance(_ context.Context, req *accounting.BalanceRequest) (*accounting.BalanceResponse, error) {
+	s.mtx.RLock()
+	val := s.balances[mapKey(req.GetBody().GetOwnerID().GetValue())]
+	s.mtx.RUnlock()
+
+	dec := new(accounting.Decimal)
+	dec.SetValue(val)
+	dec.SetPrecision(balancePrecision)
+
+	body := new(accounting.BalanceResponseBody)
+	body.SetBalance(dec)
+
+	resp := new(accounting.BalanceResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
@@ -0,0 +1,198 @@
+package inmem
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/container"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/pkg/errors"
+)
+
+type containerService Node
+
+func (s *containerService) Put(_ context.Context, req *container.PutRequest) (*container.PutResponse, error) {
+	cnr := req.GetBody().GetContainer()
+	if cnr == nil {
+		return nil, errors.New("missing container")
+	}
+
+	cid, err := container.CalculateID(cnr)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mtx.Lock()
+	s.containers[mapKey(cid.GetValue())] = cnr
+	s.mtx.Unlock()
+
+	body := new(container.PutResponseBody)
+	body.SetContainerID(cid)
+
+	resp := new(container.PutResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
+
+func (s *containerService) Delete(_ context.Context, req *container.DeleteRequest) (*container.DeleteResponse, error) {
+	key := mapKey(req.GetBody().GetContainerID().GetValue())
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if _, ok := s.containers[key]; !ok {
+		return nil, errors.Wrap(ErrNotFound, "container")
+	}
+
+	delete(s.containers, key)
+	delete(s.eacls, key)
+
+	resp := new(container.DeleteResponse)
+	resp.SetBody(new(container.DeleteResponseBody))
+
+	return resp, nil
+}
+
+func (s *containerService) Get(_ context.Context, req *container.GetRequest) (*container.GetResponse, error) {
+	s.mtx.RLock()
+	cnr, ok := s.containers[mapKey(req.GetBody().GetContainerID().GetValue())]
+	s.mtx.RUnlock()
+
+	if !ok {
+		return nil, errors.Wrap(ErrNotFound, "container")
+	}
+
+	body := new(container.GetResponseBody)
+	body.SetContainer(cnr)
+
+	resp := new(container.GetResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
+
+func (s *containerService) List(_ context.Context, req *container.ListRequest) (*container.ListResponse, error) {
+	owner := req.GetBody().GetOwnerID().GetValue()
+
+	var keys []string
+
+	s.mtx.RLock()
+
+	for key, cnr := range s.containers {
+		if bytes.Equal(cnr.GetOwnerID().GetValue(), owner) {
+			keys = append(keys, key)
+		}
+	}
+
+	s.mtx.RUnlock()
+
+	sort.Strings(keys)
+
+	ids := make([]*refs.ContainerID, 0, len(keys))
+
+	for _, key := range keys {
+		ids = append(ids, containerID(key))
+	}
+
+	body := new(container.ListResponseBody)
+	body.SetContainerIDs(ids)
+
+	resp := new(container.ListResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
+
+func (s *containerService) SetExtendedACL(_ context.Context, req *container.SetExtendedACLRequest) (*container.SetExtendedACLResponse, error) {
+	table := req.GetBody().GetEACL()
+	key := mapKey(table.GetContainerID().GetValue())
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if _, ok := s.containers[key]; !ok {
+		return nil, errors.Wrap(ErrNotFound, "container")
+	}
+
+	s.eacls[key] = &eaclRecord{
+		table: table,
+		sig:   req.GetBody().GetSignature(),
+	}
+
+	resp := new(container.SetExtendedACLResponse)
+	resp.SetBody(new(container.SetExtendedACLResponseBody))
+
+	return resp, nil
+}
+
+func (s *containerService) GetExtendedACL(_ context.Context, req *container.GetExtendedACLRequest) (*container.GetExtendedACLResponse, error) {
+	s.mtx.RLock()
+	rec, ok := s.eacls[mapKey(req.GetBody().GetContainerID().GetValue())]
+	s.mtx.RUnlock()
+
+	if !ok {
+		return nil, errors.Wrap(ErrNotFound, "extended ACL")
+	}
+
+	body := new(container.GetExtendedACLResponseBody)
+	body.SetEACL(rec.table)
+	body.SetSignature(rec.sig)
+
+	resp := new(container.GetExtendedACLResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
+
+func (s *containerService) AnnounceUsedSpace(_ context.Context, req *container.AnnounceUsedSpaceRequest) (*container.AnnounceUsedSpaceResponse, error) {
+	s.mtx.Lock()
+
+	for _, a := range req.GetBody().GetAnnouncements() {
+		m, ok := s.announcements[a.GetEpoch()]
+		if !ok {
+			m = make(map[string]*container.UsedSpaceAnnouncement)
+			s.announcements[a.GetEpoch()] = m
+		}
+
+		m[mapKey(a.GetContainerID().GetValue())] = a
+	}
+
+	s.mtx.Unlock()
+
+	resp := new(container.AnnounceUsedSpaceResponse)
+	resp.SetBody(new(container.AnnounceUsedSpaceResponseBody))
+
+	return resp, nil
+}
+
+// Announcements returns the latest used space announcements of the epoch
+// sorted by container ID.
+func (n *Node) Announcements(epoch uint64) []*container.UsedSpaceAnnouncement {
+	n.mtx.RLock()
+	defer n.mtx.RUnlock()
+
+	m := n.announcements[epoch]
+
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	res := make([]*container.UsedSpaceAnnouncement, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, m[key])
+	}
+
+	return res
+}
+
+func containerID(key string) *refs.ContainerID {
+	cid := new(refs.ContainerID)
+	cid.SetValue(mustDecodeKey(key))
+
+	return cid
+}
@@ -0,0 +1,34 @@
+package inmem
+
+import (
+	"context"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/netmap"
+)
+
+type netmapService Node
+
+func (s *netmapService) LocalNodeInfo(context.Context, *netmap.LocalNodeInfoRequest) (*netmap.LocalNodeInfoResponse, error) {
+	body := new(netmap.LocalNodeInfoResponseBody)
+	body.SetVersion(s.cfg.version)
+	body.SetNodeInfo(s.cfg.nodeInfo)
+
+	resp := new(netmap.LocalNodeInfoResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
+
+func (s *netmapService) NetworkInfo(context.Context, *netmap.NetworkInfoRequest) (*netmap.NetworkInfoResponse, error) {
+	info := new(netmap.NetworkInfo)
+	info.SetCurrentEpoch((*Node)(s).Epoch())
+	info.SetMagicNumber(s.cfg.magic)
+
+	body := new(netmap.NetworkInfoResponseBody)
+	body.SetNetworkInfo(info)
+
+	resp := new(netmap.NetworkInfoResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
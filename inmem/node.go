@@ -0,0 +1,319 @@
+/*
+Package inmem contains in-memory NeoFS node which implements all API services.
+
+Node is intended for tests: it stores everything in memory, does not
+replicate data and does not check access rights. Services can be used
+directly or served over gRPC.
+*/
+package inmem
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"sync"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/accounting"
+	"github.com/cthulhu-rider/neofs-api-go/v2/acl"
+	"github.com/cthulhu-rider/neofs-api-go/v2/container"
+	"github.com/cthulhu-rider/neofs-api-go/v2/netmap"
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/session"
+	"github.com/cthulhu-rider/neofs-api-go/v2/signature"
+	utilsig "github.com/cthulhu-rider/neofs-api-go/v2/util/signature"
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+// Node is an in-memory NeoFS node.
+//
+// Node is safe for concurrent use.
+type Node struct {
+	cfg *cfg
+
+	mtx sync.RWMutex
+
+	epoch uint64
+
+	balances map[string]int64
+
+	containers map[string]*container.Container
+
+	eacls map[string]*eaclRecord
+
+	announcements map[uint64]map[string]*container.UsedSpaceAnnouncement
+
+	sessions map[string]*sessionRecord
+
+	objects map[string]*object.Object
+
+	// split information of parent objects
+	splits map[string]*object.SplitInfo
+
+	// tombstone addresses of removed objects
+	removed map[string]*refs.Address
+}
+
+type sessionRecord struct {
+	owner *refs.OwnerID
+
+	exp uint64
+
+	key *ecdsa.PrivateKey
+}
+
+type eaclRecord struct {
+	table *acl.Table
+
+	sig *refs.Signature
+}
+
+// Option is a Node configuration option.
+type Option func(*cfg)
+
+type cfg struct {
+	key *ecdsa.PrivateKey
+
+	signOpts []utilsig.SignOption
+
+	signing bool
+
+	version *refs.Version
+
+	nodeInfo *netmap.NodeInfo
+
+	magic uint64
+
+	chunkSize int
+
+	tombstoneLifetime uint64
+}
+
+// ErrNotFound is returned when requested entity is missing.
+var ErrNotFound = errors.New("not found")
+
+// ErrRemoved is returned when requested object is removed.
+var ErrRemoved = errors.New("object already removed")
+
+const (
+	defaultChunkSize = 1 << 20
+
+	defaultTombstoneLifetime = 5
+)
+
+func defaultCfg() *cfg {
+	return &cfg{
+		version:           refs.CurrentVersion(),
+		chunkSize:         defaultChunkSize,
+		tombstoneLifetime: defaultTombstoneLifetime,
+	}
+}
+
+// WithKey returns option to set private key of the node.
+//
+// The key is used to sign tombstones and, if signing is enabled,
+// service responses. By default, random key is generated.
+func WithKey(v *ecdsa.PrivateKey) Option {
+	return func(c *cfg) {
+		c.key = v
+	}
+}
+
+// WithSignOptions returns option to set signature options of the node.
+func WithSignOptions(v ...utilsig.SignOption) Option {
+	return func(c *cfg) {
+		c.signOpts = v
+	}
+}
+
+// WithSigning returns option to verify requests and sign responses
+// of the services registered on gRPC server.
+func WithSigning() Option {
+	return func(c *cfg) {
+		c.signing = true
+	}
+}
+
+// WithVersion returns option to set API version of the node.
+func WithVersion(v *refs.Version) Option {
+	return func(c *cfg) {
+		c.version = v
+	}
+}
+
+// WithNodeInfo returns option to set information about the node
+// returned by netmap service.
+//
+// By default, online node with the public key of the node is used.
+func WithNodeInfo(v *netmap.NodeInfo) Option {
+	return func(c *cfg) {
+		c.nodeInfo = v
+	}
+}
+
+// WithMagic returns option to set magic number of the network.
+func WithMagic(v uint64) Option {
+	return func(c *cfg) {
+		c.magic = v
+	}
+}
+
+// WithChunkSize returns option to set maximum size of the payload chunk
+// in Get and GetRange responses.
+//
+// Non-positive values are ignored, default size is 1MB.
+func WithChunkSize(v int) Option {
+	return func(c *cfg) {
+		if v > 0 {
+			c.chunkSize = v
+		}
+	}
+}
+
+// NewNode creates new empty Node.
+func NewNode(opts ...Option) (*Node, error) {
+	c := defaultCfg()
+
+	for i := range opts {
+		opts[i](c)
+	}
+
+	if c.key == nil {
+		var err error
+
+		if c.key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader); err != nil {
+			return nil, errors.Wrap(err, "could not generate node key")
+		}
+	}
+
+	if c.nodeInfo == nil {
+		c.nodeInfo = new(netmap.NodeInfo)
+		c.nodeInfo.SetPublicKey(crypto.MarshalPublicKey(&c.key.PublicKey))
+		c.nodeInfo.SetState(netmap.Online)
+	}
+
+	return &Node{
+		cfg:           c,
+		balances:      make(map[string]int64),
+		containers:    make(map[string]*container.Container),
+		eacls:         make(map[string]*eaclRecord),
+		announcements: make(map[uint64]map[string]*container.UsedSpaceAnnouncement),
+		sessions:      make(map[string]*sessionRecord),
+		objects:       make(map[string]*object.Object),
+		splits:        make(map[string]*object.SplitInfo),
+		removed:       make(map[string]*refs.Address),
+	}, nil
+}
+
+// Epoch returns current epoch of the node.
+func (n *Node) Epoch() uint64 {
+	n.mtx.RLock()
+	defer n.mtx.RUnlock()
+
+	return n.epoch
+}
+
+// SetEpoch sets current epoch of the node.
+func (n *Node) SetEpoch(v uint64) {
+	n.mtx.Lock()
+	n.epoch = v
+	n.mtx.Unlock()
+}
+
+// SetBalance sets balance of the owner.
+func (n *Node) SetBalance(owner *refs.OwnerID, v int64) {
+	n.mtx.Lock()
+	n.balances[mapKey(owner.GetValue())] = v
+	n.mtx.Unlock()
+}
+
+// Accounting returns accounting service of the node.
+func (n *Node) Accounting() accounting.Service {
+	return (*accountingService)(n)
+}
+
+// Container returns container service of the node.
+func (n *Node) Container() container.Service {
+	return (*containerService)(n)
+}
+
+// Netmap returns netmap service of the node.
+func (n *Node) Netmap() netmap.Service {
+	return (*netmapService)(n)
+}
+
+// Object returns object service of the node.
+func (n *Node) Object() object.Service {
+	return (*objectService)(n)
+}
+
+// Session returns session service of the node.
+func (n *Node) Session() session.Service {
+	return (*sessionService)(n)
+}
+
+// Register registers all services of the node on gRPC server.
+func (n *Node) Register(gs *grpc.Server) {
+	var (
+		accountingSvc = n.Accounting()
+		containerSvc  = n.Container()
+		netmapSvc     = n.Netmap()
+		objectSvc     = n.Object()
+		sessionSvc    = n.Session()
+	)
+
+	if n.cfg.signing {
+		opt := signature.WithSignOptions(n.cfg.signOpts...)
+
+		accountingSvc = signature.WrapAccountingServer(accountingSvc, n.cfg.key, opt)
+		containerSvc = signature.WrapContainerServer(containerSvc, n.cfg.key, opt)
+		netmapSvc = signature.WrapNetmapServer(netmapSvc, n.cfg.key, opt)
+		objectSvc = signature.WrapObjectServer(objectSvc, n.cfg.key, opt)
+		sessionSvc = signature.WrapSessionServer(sessionSvc, n.cfg.key, opt)
+	}
+
+	accounting.RegisterGRPCServer(gs, accountingSvc)
+	container.RegisterGRPCServer(gs, containerSvc)
+	netmap.RegisterGRPCServer(gs, netmapSvc)
+	object.RegisterGRPCServer(gs, objectSvc)
+	session.RegisterGRPCServer(gs, sessionSvc)
+}
+
+// Serve serves all services of the node over gRPC on the listener.
+//
+// Serve blocks until the listener fails or is closed.
+func (n *Node) Serve(lis net.Listener, opts ...grpc.ServerOption) error {
+	gs := grpc.NewServer(opts...)
+	n.Register(gs)
+
+	return gs.Serve(lis)
+}
+
+func mapKey(v []byte) string {
+	return hex.EncodeToString(v)
+}
+
+func addressKey(addr *refs.Address) string {
+	return mapKey(addr.GetContainerID().GetValue()) + "/" + mapKey(addr.GetObjectID().GetValue())
+}
+
+func address(cid *refs.ContainerID, id *refs.ObjectID) *refs.Address {
+	addr := new(refs.Address)
+	addr.SetContainerID(cid)
+	addr.SetObjectID(id)
+
+	return addr
+}
+
+func mustDecodeKey(key string) []byte {
+	v, err := hex.DecodeString(key)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
@@ -0,0 +1,339 @@
+package inmem_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io/ioutil"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/accounting"
+	"github.com/cthulhu-rider/neofs-api-go/v2/client"
+	"github.com/cthulhu-rider/neofs-api-go/v2/container"
+	"github.com/cthulhu-rider/neofs-api-go/v2/inmem"
+	"github.com/cthulhu-rider/neofs-api-go/v2/internal/grpctest"
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/object/stream"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/signature"
+	utilsig "github.com/cthulhu-rider/neofs-api-go/v2/util/signature"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type clients struct {
+	accounting accounting.Service
+
+	container container.Service
+
+	object object.Service
+}
+
+func newClients(t *testing.T, n *inmem.Node) *clients {
+	conn := grpctest.Dial(t, n.Register)
+
+	var (
+		key  = test.DecodeKey(1)
+		opt  = signature.WithSignOptions(utilsig.SignWithRFC6979())
+		glob = client.WithGRPCConn(conn)
+	)
+
+	accountingCli, err := accounting.NewClient(accounting.WithGlobalOpts(glob))
+	require.NoError(t, err)
+
+	containerCli, err := container.NewClient(container.WithGlobalOpts(glob))
+	require.NoError(t, err)
+
+	objectCli, err := object.NewClient(object.WithGlobalOpts(glob))
+	require.NoError(t, err)
+
+	return &clients{
+		accounting: signature.WrapAccountingClient(accountingCli, key, opt),
+		container:  signature.WrapContainerClient(containerCli, key, opt),
+		object:     signature.WrapObjectClient(objectCli, key, opt),
+	}
+}
+
+func newNode(t *testing.T) *inmem.Node {
+	n, err := inmem.NewNode(
+		inmem.WithKey(test.DecodeKey(0)),
+		inmem.WithSignOptions(utilsig.SignWithRFC6979()),
+		inmem.WithSigning(),
+		inmem.WithChunkSize(100),
+	)
+	require.NoError(t, err)
+
+	return n
+}
+
+func testOwner() *refs.OwnerID {
+	owner := new(refs.OwnerID)
+	owner.SetValue(bytes.Repeat([]byte{1}, 25))
+
+	return owner
+}
+
+func putContainer(t *testing.T, svc container.Service) *refs.ContainerID {
+	cnr := new(container.Container)
+	cnr.SetOwnerID(testOwner())
+	cnr.SetNonce([]byte{1, 2, 3})
+
+	body := new(container.PutRequestBody)
+	body.SetContainer(cnr)
+
+	req := new(container.PutRequest)
+	req.SetBody(body)
+
+	resp, err := svc.Put(context.Background(), req)
+	require.NoError(t, err)
+
+	return resp.GetBody().GetContainerID()
+}
+
+func putObject(t *testing.T, svc object.Service, obj *object.Object) {
+	init := new(object.PutObjectPartInit)
+	init.SetObjectID(obj.GetObjectID())
+	init.SetSignature(obj.GetSignature())
+	init.SetHeader(obj.GetHeader())
+
+	w, err := stream.Put(context.Background(), svc, init, stream.WithChunkSize(64))
+	require.NoError(t, err)
+
+	_, err = w.Write(obj.GetPayload())
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.Equal(t, obj.GetObjectID(), w.ObjectID())
+}
+
+func sliceObject(t *testing.T, cid *refs.ContainerID, payload []byte) (*refs.ObjectID, []*object.Object) {
+	ver := new(refs.Version)
+	ver.SetMajor(2)
+
+	hdr := new(object.Header)
+	hdr.SetVersion(ver)
+	hdr.SetContainerID(cid)
+	hdr.SetOwnerID(testOwner())
+
+	var objs []*object.Object
+
+	id, err := object.NewSlicer(hdr, test.DecodeKey(1),
+		object.WithPartSize(300),
+		object.WithSignOptions(utilsig.SignWithRFC6979()),
+	).Slice(bytes.NewReader(payload), func(obj *object.Object) error {
+		objs = append(objs, obj)
+		return nil
+	})
+	require.NoError(t, err)
+
+	return id, objs
+}
+
+func address(cid *refs.ContainerID, id *refs.ObjectID) *refs.Address {
+	addr := new(refs.Address)
+	addr.SetContainerID(cid)
+	addr.SetObjectID(id)
+
+	return addr
+}
+
+func search(t *testing.T, svc object.Service, cid *refs.ContainerID, query string) []*refs.ObjectID {
+	fs, err := object.ParseSearchFilters(query)
+	require.NoError(t, err)
+
+	body := new(object.SearchRequestBody)
+	body.SetContainerID(cid)
+	body.SetFilters(fs)
+
+	req := new(object.SearchRequest)
+	req.SetBody(body)
+
+	s, err := svc.Search(context.Background(), req)
+	require.NoError(t, err)
+
+	resp, err := s.Recv()
+	require.NoError(t, err)
+
+	return resp.GetBody().GetIDList()
+}
+
+func TestNode(t *testing.T) {
+	n := newNode(t)
+	c := newClients(t, n)
+	ctx := context.Background()
+
+	t.Run("balance", func(t *testing.T) {
+		n.SetBalance(testOwner(), 42)
+
+		body := new(accounting.BalanceRequestBody)
+		body.SetOwnerID(testOwner())
+
+		req := new(accounting.BalanceRequest)
+		req.SetBody(body)
+
+		resp, err := c.accounting.Balance(ctx, req)
+		require.NoError(t, err)
+		require.EqualValues(t, 42, resp.GetBody().GetBalance().GetValue())
+	})
+
+	cid := putContainer(t, c.container)
+
+	t.Run("container", func(t *testing.T) {
+		body := new(container.ListRequestBody)
+		body.SetOwnerID(testOwner())
+
+		req := new(container.ListRequest)
+		req.SetBody(body)
+
+		resp, err := c.container.List(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, []*refs.ContainerID{cid}, resp.GetBody().GetContainerIDs())
+	})
+
+	payload := make([]byte, 1000)
+
+	_, err := rand.Read(payload)
+	require.NoError(t, err)
+
+	parID, objs := sliceObject(t, cid, payload)
+	require.Len(t, objs, 5)
+
+	for _, obj := range objs {
+		putObject(t, c.object, obj)
+	}
+
+	addr := address(cid, parID)
+
+	t.Run("get", func(t *testing.T) {
+		init, r, err := stream.Get(ctx, c.object, addr)
+		require.NoError(t, err)
+		require.Equal(t, parID, init.GetObjectID())
+
+		data, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, payload, data)
+
+		_, _, err = stream.Get(ctx, c.object, addr, stream.WithRaw(true))
+
+		var splitErr *stream.SplitInfoError
+		require.True(t, errors.As(err, &splitErr))
+		require.Equal(t, objs[len(objs)-1].GetObjectID(), splitErr.SplitInfo().GetLink())
+	})
+
+	t.Run("range", func(t *testing.T) {
+		r, err := stream.NewRangeReader(ctx, c.object, addr)
+		require.NoError(t, err)
+
+		buf := make([]byte, 200)
+
+		_, err = r.ReadAt(buf, 250)
+		require.NoError(t, err)
+		require.Equal(t, payload[250:450], buf)
+
+		rng := new(object.Range)
+		rng.SetLength(1)
+
+		body := new(object.GetRangeRequestBody)
+		body.SetAddress(addr)
+		body.SetRange(rng)
+		body.SetRaw(true)
+
+		req := new(object.GetRangeRequest)
+		req.SetBody(body)
+
+		rs, err := c.object.GetRange(ctx, req)
+		require.NoError(t, err)
+
+		resp, err := rs.Recv()
+		require.NoError(t, err)
+
+		info, ok := resp.GetBody().GetRangePart().(*object.SplitInfo)
+		require.True(t, ok)
+		require.Equal(t, objs[len(objs)-1].GetObjectID(), info.GetLink())
+	})
+
+	t.Run("search", func(t *testing.T) {
+		require.Equal(t, []*refs.ObjectID{parID}, search(t, c.object, cid, object.FilterPropertyRoot))
+		require.Len(t, search(t, c.object, cid, object.FilterPropertyPhy), len(objs))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		body := new(object.DeleteRequestBody)
+		body.SetAddress(addr)
+
+		req := new(object.DeleteRequest)
+		req.SetBody(body)
+
+		resp, err := c.object.Delete(ctx, req)
+		require.NoError(t, err)
+
+		tsAddr := resp.GetBody().GetTombstone()
+		require.Equal(t, cid, tsAddr.GetContainerID())
+
+		_, _, err = stream.Get(ctx, c.object, addr)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), inmem.ErrRemoved.Error())
+
+		require.Equal(t, []*refs.ObjectID{tsAddr.GetObjectID()}, search(t, c.object, cid, ""))
+
+		_, r, err := stream.Get(ctx, c.object, tsAddr)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+	})
+}
+
+func TestNode_Put(t *testing.T) {
+	n := newNode(t)
+	svc := n.Object()
+
+	_, objs := sliceObject(t, new(refs.ContainerID), []byte{1, 2, 3})
+
+	t.Run("missing container", func(t *testing.T) {
+		init := new(object.PutObjectPartInit)
+		init.SetObjectID(objs[0].GetObjectID())
+		init.SetHeader(objs[0].GetHeader())
+
+		w, err := stream.Put(context.Background(), svc, init)
+		require.NoError(t, err)
+		require.True(t, errors.Is(w.Close(), inmem.ErrNotFound))
+	})
+
+	t.Run("corrupted payload", func(t *testing.T) {
+		cid := putContainer(t, n.Container())
+		id, objs := sliceObject(t, cid, []byte{1, 2, 3})
+
+		init := new(object.PutObjectPartInit)
+		init.SetObjectID(id)
+		init.SetHeader(objs[0].GetHeader())
+
+		w, err := stream.Put(context.Background(), svc, init)
+		require.NoError(t, err)
+
+		_, err = w.Write([]byte{3, 2, 1})
+		require.NoError(t, err)
+		require.Error(t, w.Close())
+	})
+}
+
+func TestWithChunkSize(t *testing.T) {
+	n, err := inmem.NewNode(inmem.WithChunkSize(0))
+	require.NoError(t, err)
+
+	cid := putContainer(t, n.Container())
+
+	payload := make([]byte, 10)
+
+	parID, objs := sliceObject(t, cid, payload)
+	require.Len(t, objs, 1)
+
+	putObject(t, n.Object(), objs[0])
+
+	_, r, err := stream.Get(context.Background(), n.Object(), address(cid, parID))
+	require.NoError(t, err)
+
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, payload, data)
+}
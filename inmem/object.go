@@ -0,0 +1,640 @@
+package inmem
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/tombstone"
+	"github.com/pkg/errors"
+)
+
+type objectService Node
+
+type getStream struct {
+	resps []*object.GetResponse
+}
+
+type getRangeStream struct {
+	resps []*object.GetRangeResponse
+}
+
+type searchStream struct {
+	resps []*object.SearchResponse
+}
+
+type putStream struct {
+	svc *objectService
+
+	init *object.PutObjectPartInit
+
+	payload bytes.Buffer
+}
+
+func (s *getStream) Recv() (*object.GetResponse, error) {
+	if len(s.resps) == 0 {
+		return nil, io.EOF
+	}
+
+	resp := s.resps[0]
+	s.resps = s.resps[1:]
+
+	return resp, nil
+}
+
+func (s *getStream) add(part object.GetObjectPart) {
+	body := new(object.GetResponseBody)
+	body.SetObjectPart(part)
+
+	resp := new(object.GetResponse)
+	resp.SetBody(body)
+
+	s.resps = append(s.resps, resp)
+}
+
+func (s *getRangeStream) Recv() (*object.GetRangeResponse, error) {
+	if len(s.resps) == 0 {
+		return nil, io.EOF
+	}
+
+	resp := s.resps[0]
+	s.resps = s.resps[1:]
+
+	return resp, nil
+}
+
+func (s *getRangeStream) add(part object.GetRangePart) {
+	body := new(object.GetRangeResponseBody)
+	body.SetRangePart(part)
+
+	resp := new(object.GetRangeResponse)
+	resp.SetBody(body)
+
+	s.resps = append(s.resps, resp)
+}
+
+func (s *searchStream) Recv() (*object.SearchResponse, error) {
+	if len(s.resps) == 0 {
+		return nil, io.EOF
+	}
+
+	resp := s.resps[0]
+	s.resps = s.resps[1:]
+
+	return resp, nil
+}
+
+func (s *putStream) Send(req *object.PutRequest) error {
+	switch part := req.GetBody().GetObjectPart().(type) {
+	case *object.PutObjectPartInit:
+		if s.init != nil {
+			return errors.New("repeated init part")
+		}
+
+		s.init = part
+	case *object.PutObjectPartChunk:
+		if s.init == nil {
+			return errors.New("chunk part before init part")
+		}
+
+		s.payload.Write(part.GetChunk())
+	default:
+		return errors.Errorf("unexpected object part %T", part)
+	}
+
+	return nil
+}
+
+func (s *putStream) CloseAndRecv() (*object.PutResponse, error) {
+	if s.init == nil {
+		return nil, errors.New("missing init part")
+	}
+
+	obj := new(object.Object)
+	obj.SetObjectID(s.init.GetObjectID())
+	obj.SetSignature(s.init.GetSignature())
+	obj.SetHeader(s.init.GetHeader())
+	obj.SetPayload(s.payload.Bytes())
+
+	s.svc.mtx.Lock()
+	err := s.svc.put(obj)
+	s.svc.mtx.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	body := new(object.PutResponseBody)
+	body.SetObjectID(obj.GetObjectID())
+
+	resp := new(object.PutResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
+
+func (s *objectService) Put(context.Context) (object.PutObjectStreamer, error) {
+	return &putStream{
+		svc: s,
+	}, nil
+}
+
+// put stores the object. Must be called with the write lock held.
+func (s *objectService) put(obj *object.Object) error {
+	hdr := obj.GetHeader()
+	cid := hdr.GetContainerID()
+
+	if _, ok := s.containers[mapKey(cid.GetValue())]; !ok {
+		return errors.Wrap(ErrNotFound, "container")
+	}
+
+	if err := object.VerifyID(obj); err != nil {
+		return errors.Wrap(err, "invalid object ID")
+	}
+
+	if err := object.VerifyPayloadChecksum(obj); err != nil {
+		return errors.Wrap(err, "invalid payload checksum")
+	}
+
+	key := addressKey(address(cid, obj.GetObjectID()))
+
+	if _, ok := s.removed[key]; ok {
+		return ErrRemoved
+	}
+
+	if hdr.GetObjectType() == object.TypeTombstone {
+		ts := new(tombstone.Tombstone)
+		if err := ts.StableUnmarshal(obj.GetPayload()); err != nil {
+			return errors.Wrap(err, "could not decode tombstone")
+		}
+
+		tsAddr := address(cid, obj.GetObjectID())
+
+		for _, id := range ts.GetMembers() {
+			memberKey := addressKey(address(cid, id))
+
+			delete(s.objects, memberKey)
+			delete(s.splits, memberKey)
+			s.removed[memberKey] = tsAddr
+		}
+	}
+
+	s.objects[key] = obj
+
+	split := hdr.GetSplit()
+	if split.GetParent() == nil {
+		return nil
+	}
+
+	parKey := addressKey(address(cid, split.GetParent()))
+
+	info, ok := s.splits[parKey]
+	if !ok {
+		info = new(object.SplitInfo)
+		info.SetSplitID(split.GetSplitID())
+		s.splits[parKey] = info
+	}
+
+	if len(split.GetChildren()) > 0 {
+		info.SetLink(obj.GetObjectID())
+	} else {
+		info.SetLastPart(obj.GetObjectID())
+	}
+
+	return nil
+}
+
+// get returns stored or assembled object, or split information of the
+// parent object if raw is set. Must be called with the read lock held.
+func (s *objectService) get(addr *refs.Address, raw bool) (*object.Object, *object.SplitInfo, error) {
+	key := addressKey(addr)
+
+	if _, ok := s.removed[key]; ok {
+		return nil, nil, ErrRemoved
+	}
+
+	if obj, ok := s.objects[key]; ok {
+		return obj, nil, nil
+	}
+
+	info, ok := s.splits[key]
+	if !ok {
+		return nil, nil, errors.Wrap(ErrNotFound, "object")
+	}
+
+	if raw {
+		return nil, info, nil
+	}
+
+	obj, err := s.assemble(addr, info)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return obj, nil, nil
+}
+
+// parent returns parent object without payload from the child objects
+// described by split information.
+func (s *objectService) parent(addr *refs.Address, info *object.SplitInfo) (*object.Object, error) {
+	var split *object.SplitHeader
+
+	for _, id := range []*refs.ObjectID{info.GetLink(), info.GetLastPart()} {
+		if id == nil {
+			continue
+		}
+
+		if child, ok := s.objects[addressKey(address(addr.GetContainerID(), id))]; ok {
+			split = child.GetHeader().GetSplit()
+			break
+		}
+	}
+
+	if split.GetParentHeader() == nil {
+		return nil, errors.Wrap(ErrNotFound, "parent header")
+	}
+
+	par := new(object.Object)
+	par.SetObjectID(addr.GetObjectID())
+	par.SetSignature(split.GetParentSignature())
+	par.SetHeader(split.GetParentHeader())
+
+	return par, nil
+}
+
+// children returns identifiers of the child objects described by split
+// information in payload order.
+func (s *objectService) children(cid *refs.ContainerID, info *object.SplitInfo) ([]*refs.ObjectID, error) {
+	if id := info.GetLink(); id != nil {
+		if link, ok := s.objects[addressKey(address(cid, id))]; ok {
+			return link.GetHeader().GetSplit().GetChildren(), nil
+		}
+	}
+
+	var (
+		ids     []*refs.ObjectID
+		visited = make(map[string]struct{})
+	)
+
+	for id := info.GetLastPart(); id != nil; {
+		if _, ok := visited[string(id.GetValue())]; ok {
+			return nil, errors.New("cyclic reference to the previous object")
+		}
+
+		visited[string(id.GetValue())] = struct{}{}
+
+		child, ok := s.objects[addressKey(address(cid, id))]
+		if !ok {
+			return nil, errors.Wrap(ErrNotFound, "child object")
+		}
+
+		ids = append(ids, id)
+		id = child.GetHeader().GetSplit().GetPrevious()
+	}
+
+	for i, j := 0, len(ids)-1; i < j; i, j = i+1, j-1 {
+		ids[i], ids[j] = ids[j], ids[i]
+	}
+
+	return ids, nil
+}
+
+func (s *objectService) assemble(addr *refs.Address, info *object.SplitInfo) (*object.Object, error) {
+	par, err := s.parent(addr, info)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := s.children(addr.GetContainerID(), info)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := make([]byte, 0, par.GetHeader().GetPayloadLength())
+
+	for _, id := range ids {
+		child, ok := s.objects[addressKey(address(addr.GetContainerID(), id))]
+		if !ok {
+			return nil, errors.Wrap(ErrNotFound, "child object")
+		}
+
+		payload = append(payload, child.GetPayload()...)
+	}
+
+	par.SetPayload(payload)
+
+	return par, nil
+}
+
+func (s *objectService) Get(_ context.Context, req *object.GetRequest) (object.GetObjectStreamer, error) {
+	s.mtx.RLock()
+	obj, info, err := s.get(req.GetBody().GetAddress(), req.GetBody().GetRaw())
+	s.mtx.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	stream := new(getStream)
+
+	if info != nil {
+		stream.add(info)
+
+		return stream, nil
+	}
+
+	init := new(object.GetObjectPartInit)
+	init.SetObjectID(obj.GetObjectID())
+	init.SetSignature(obj.GetSignature())
+	init.SetHeader(obj.GetHeader())
+
+	stream.add(init)
+
+	for _, chunk := range s.chunks(obj.GetPayload()) {
+		part := new(object.GetObjectPartChunk)
+		part.SetChunk(chunk)
+
+		stream.add(part)
+	}
+
+	return stream, nil
+}
+
+func (s *objectService) chunks(data []byte) [][]byte {
+	var res [][]byte
+
+	for len(data) > 0 {
+		n := s.cfg.chunkSize
+		if n > len(data) {
+			n = len(data)
+		}
+
+		res = append(res, data[:n])
+		data = data[n:]
+	}
+
+	return res
+}
+
+func (s *objectService) Head(_ context.Context, req *object.HeadRequest) (*object.HeadResponse, error) {
+	var (
+		addr = req.GetBody().GetAddress()
+		key  = addressKey(addr)
+		body = new(object.HeadResponseBody)
+
+		obj *object.Object
+		err error
+	)
+
+	s.mtx.RLock()
+
+	if _, ok := s.removed[key]; ok {
+		err = ErrRemoved
+	} else if stored, ok := s.objects[key]; ok {
+		obj = stored
+	} else if info, ok := s.splits[key]; !ok {
+		err = errors.Wrap(ErrNotFound, "object")
+	} else if req.GetBody().GetRaw() {
+		body.SetHeaderPart(info)
+	} else {
+		obj, err = s.parent(addr, info)
+	}
+
+	s.mtx.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if obj != nil {
+		hdr := obj.GetHeader()
+
+		if req.GetBody().GetMainOnly() {
+			short := new(object.ShortHeader)
+			short.SetVersion(hdr.GetVersion())
+			short.SetCreationEpoch(hdr.GetCreationEpoch())
+			short.SetOwnerID(hdr.GetOwnerID())
+			short.SetObjectType(hdr.GetObjectType())
+			short.SetPayloadLength(hdr.GetPayloadLength())
+			short.SetPayloadHash(hdr.GetPayloadHash())
+			short.SetHomomorphicHash(hdr.GetHomomorphicHash())
+
+			body.SetHeaderPart(short)
+		} else {
+			full := new(object.HeaderWithSignature)
+			full.SetHeader(hdr)
+			full.SetSignature(obj.GetSignature())
+
+			body.SetHeaderPart(full)
+		}
+	}
+
+	resp := new(object.HeadResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
+
+func (s *objectService) Search(_ context.Context, req *object.SearchRequest) (object.SearchObjectStreamer, error) {
+	var (
+		cid    = req.GetBody().GetContainerID()
+		prefix = mapKey(cid.GetValue()) + "/"
+		fs     = req.GetBody().GetFilters()
+		keys   []string
+	)
+
+	s.mtx.RLock()
+
+	for key, obj := range s.objects {
+		if strings.HasPrefix(key, prefix) && object.MatchSearchFilters(obj.GetObjectID(), obj.GetHeader(), fs, true) == nil {
+			keys = append(keys, key)
+		}
+	}
+
+	for key, info := range s.splits {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		if _, ok := s.objects[key]; ok {
+			continue
+		}
+
+		id := new(refs.ObjectID)
+		id.SetValue(mustDecodeKey(key[len(prefix):]))
+
+		par, err := s.parent(address(cid, id), info)
+		if err == nil && object.MatchSearchFilters(id, par.GetHeader(), fs, false) == nil {
+			keys = append(keys, key)
+		}
+	}
+
+	s.mtx.RUnlock()
+
+	sort.Strings(keys)
+
+	ids := make([]*refs.ObjectID, 0, len(keys))
+
+	for _, key := range keys {
+		id := new(refs.ObjectID)
+		id.SetValue(mustDecodeKey(key[len(prefix):]))
+
+		ids = append(ids, id)
+	}
+
+	body := new(object.SearchResponseBody)
+	body.SetIDList(ids)
+
+	resp := new(object.SearchResponse)
+	resp.SetBody(body)
+
+	return &searchStream{
+		resps: []*object.SearchResponse{resp},
+	}, nil
+}
+
+func (s *objectService) Delete(_ context.Context, req *object.DeleteRequest) (*object.DeleteResponse, error) {
+	addr := req.GetBody().GetAddress()
+	cid := addr.GetContainerID()
+	key := addressKey(addr)
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	cnr, ok := s.containers[mapKey(cid.GetValue())]
+	if !ok {
+		return nil, errors.Wrap(ErrNotFound, "container")
+	}
+
+	if _, ok := s.removed[key]; ok {
+		return nil, ErrRemoved
+	}
+
+	ts := new(tombstone.Tombstone)
+	ts.SetExpirationEpoch(s.epoch + s.cfg.tombstoneLifetime)
+
+	members := []*refs.ObjectID{addr.GetObjectID()}
+
+	if _, ok := s.objects[key]; !ok {
+		info, ok := s.splits[key]
+		if !ok {
+			return nil, errors.Wrap(ErrNotFound, "object")
+		}
+
+		children, err := s.children(cid, info)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, children...)
+
+		if info.GetLink() != nil {
+			members = append(members, info.GetLink())
+		}
+
+		ts.SetSplitID(info.GetSplitID())
+	}
+
+	ts.SetMembers(members)
+
+	payload, err := ts.StableMarshal(nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal tombstone")
+	}
+
+	hdr := new(object.Header)
+	hdr.SetVersion(s.cfg.version)
+	hdr.SetContainerID(cid)
+	hdr.SetOwnerID(cnr.GetOwnerID())
+	hdr.SetCreationEpoch(s.epoch)
+	hdr.SetObjectType(object.TypeTombstone)
+	_ = hdr.SetExpirationEpoch(ts.GetExpirationEpoch())
+
+	obj := new(object.Object)
+	obj.SetHeader(hdr)
+	obj.SetPayload(payload)
+
+	object.CalculateAndSetPayloadChecksum(obj)
+
+	if err := object.SetVerificationFields(s.cfg.key, obj, s.cfg.signOpts...); err != nil {
+		return nil, errors.Wrap(err, "could not sign tombstone")
+	}
+
+	if err := s.put(obj); err != nil {
+		return nil, errors.Wrap(err, "could not store tombstone")
+	}
+
+	body := new(object.DeleteResponseBody)
+	body.SetTombstone(address(cid, obj.GetObjectID()))
+
+	resp := new(object.DeleteResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
+
+// payload returns the payload of the physical or assembled object.
+// payload returns payload of the stored or assembled object, or split
+// information of the parent object if raw is set.
+func (s *objectService) payload(addr *refs.Address, raw bool) ([]byte, *object.SplitInfo, error) {
+	s.mtx.RLock()
+	obj, info, err := s.get(addr, raw)
+	s.mtx.RUnlock()
+
+	if err != nil || info != nil {
+		return nil, info, err
+	}
+
+	return obj.GetPayload(), nil, nil
+}
+
+func (s *objectService) GetRange(_ context.Context, req *object.GetRangeRequest) (object.GetRangeObjectStreamer, error) {
+	payload, info, err := s.payload(req.GetBody().GetAddress(), req.GetBody().GetRaw())
+	if err != nil {
+		return nil, err
+	}
+
+	stream := new(getRangeStream)
+
+	if info != nil {
+		stream.add(info)
+
+		return stream, nil
+	}
+
+	off, ln := req.GetBody().GetRange().GetOffset(), req.GetBody().GetRange().GetLength()
+
+	if off > uint64(len(payload)) || ln > uint64(len(payload))-off {
+		return nil, errors.Wrapf(object.ErrRangeOutOfBounds, "[%d:%d] of %d bytes", off, off+ln, len(payload))
+	}
+
+	for _, chunk := range s.chunks(payload[off : off+ln]) {
+		part := new(object.GetRangePartChunk)
+		part.SetChunk(chunk)
+
+		stream.add(part)
+	}
+
+	return stream, nil
+}
+
+func (s *objectService) GetRangeHash(_ context.Context, req *object.GetRangeHashRequest) (*object.GetRangeHashResponse, error) {
+	payload, _, err := s.payload(req.GetBody().GetAddress(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := object.CalculateRangeHashResponse(payload, req.GetBody())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(object.GetRangeHashResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
@@ -0,0 +1,44 @@
+package inmem
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/internal/uuid"
+	"github.com/cthulhu-rider/neofs-api-go/v2/session"
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/pkg/errors"
+)
+
+type sessionService Node
+
+func (s *sessionService) Create(_ context.Context, req *session.CreateRequest) (*session.CreateResponse, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate session key")
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate session ID")
+	}
+
+	s.mtx.Lock()
+	s.sessions[mapKey(id)] = &sessionRecord{
+		owner: req.GetBody().GetOwnerID(),
+		exp:   req.GetBody().GetExpiration(),
+		key:   key,
+	}
+	s.mtx.Unlock()
+
+	body := new(session.CreateResponseBody)
+	body.SetID(id)
+	body.SetSessionKey(crypto.MarshalPublicKey(&key.PublicKey))
+
+	resp := new(session.CreateResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
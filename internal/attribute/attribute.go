@@ -0,0 +1,59 @@
+/*
+Package attribute contains helpers over key-value attributes of
+NeoFS entities.
+*/
+package attribute
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrDuplicate is returned when several attributes have the same key.
+var ErrDuplicate = errors.New("duplicate attribute")
+
+// ErrInvalid is returned when attribute is malformed.
+var ErrInvalid = errors.New("invalid attribute")
+
+// Find returns the index of the attribute with the key among n attributes
+// with keys returned by keyAt, or -1 if there is no such attribute.
+//
+// Returns an error wrapping ErrDuplicate if the key is met several times.
+func Find(n int, keyAt func(int) string, key string) (int, error) {
+	ind := -1
+
+	for i := 0; i < n; i++ {
+		if keyAt(i) != key {
+			continue
+		}
+
+		if ind >= 0 {
+			return -1, errors.Wrap(ErrDuplicate, key)
+		}
+
+		ind = i
+	}
+
+	return ind, nil
+}
+
+// ParseTimestamp parses the value of the attribute with the key
+// as a number of Unix seconds.
+//
+// Returns an error wrapping ErrInvalid if the value is not a number.
+func ParseTimestamp(key, v string) (time.Time, error) {
+	sec, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(ErrInvalid, "%s: invalid Unix time %q", key, v)
+	}
+
+	return time.Unix(sec, 0), nil
+}
+
+// FormatTimestamp returns the time in Unix seconds in the form
+// accepted by ParseTimestamp.
+func FormatTimestamp(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
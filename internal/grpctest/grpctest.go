@@ -0,0 +1,43 @@
+/*
+Package grpctest connects test clients to gRPC servers over in-memory listener.
+*/
+package grpctest
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1 << 20
+
+// Dial serves gRPC server with the services attached by register on the
+// in-memory listener and returns client connection to it.
+//
+// Server is stopped and connection is closed on test cleanup.
+func Dial(t testing.TB, register func(*grpc.Server)) *grpc.ClientConn {
+	lis := bufconn.Listen(bufSize)
+
+	gs := grpc.NewServer()
+	register(gs)
+
+	go func() { _ = gs.Serve(lis) }()
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+	)
+	if err != nil {
+		t.Fatalf("dial in-memory gRPC server: %v", err)
+	}
+
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
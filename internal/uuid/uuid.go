@@ -0,0 +1,24 @@
+/*
+Package uuid generates random identifiers in UUID form.
+*/
+package uuid
+
+import (
+	"crypto/rand"
+)
+
+// Size is a length of UUID in bytes.
+const Size = 16
+
+// NewV4 returns random UUID of version 4 and RFC 4122 variant.
+func NewV4() ([]byte, error) {
+	id := make([]byte, Size)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	id[6] = id[6]&0x0f | 0x40
+	id[8] = id[8]&0x3f | 0x80
+
+	return id, nil
+}
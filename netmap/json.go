@@ -1,6 +1,8 @@
 package netmap
 
 import (
+	"encoding/json"
+
 	netmap "github.com/cthulhu-rider/neofs-api-go/v2/netmap/grpc"
 	"google.golang.org/protobuf/encoding/protojson"
 )
@@ -144,3 +146,58 @@ func (i *NetworkInfo) UnmarshalJSON(data []byte) error {
 
 	return nil
 }
+
+// netmapJSON is a JSON form of the Netmap. Network map has no protobuf
+// message, so nodes are encoded with protojson separately. Encoder
+// compacts them, so the result does not depend on protojson whitespace.
+type netmapJSON struct {
+	Epoch uint64            `json:"epoch,string"`
+	Nodes []json.RawMessage `json:"nodes"`
+}
+
+func (m *Netmap) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		m = new(Netmap)
+	}
+
+	v := netmapJSON{
+		Epoch: m.epoch,
+		Nodes: make([]json.RawMessage, 0, len(m.nodes)),
+	}
+
+	for i := range m.nodes {
+		data, err := m.nodes[i].MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+
+		v.Nodes = append(v.Nodes, data)
+	}
+
+	return json.Marshal(v)
+}
+
+func (m *Netmap) UnmarshalJSON(data []byte) error {
+	v := new(netmapJSON)
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	*m = Netmap{
+		epoch: v.Epoch,
+		nodes: make([]*NodeInfo, 0, len(v.Nodes)),
+	}
+
+	for i := range v.Nodes {
+		ni := new(NodeInfo)
+
+		if err := ni.UnmarshalJSON(v.Nodes[i]); err != nil {
+			return err
+		}
+
+		m.nodes = append(m.nodes, ni)
+	}
+
+	return m.reindex()
+}
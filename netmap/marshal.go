@@ -1,9 +1,8 @@
 package netmap
 
 import (
-	netmap "github.com/cthulhu-rider/neofs-api-go/v2/netmap/grpc"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
 	protoutil "github.com/cthulhu-rider/neofs-api-go/v2/util/proto"
-	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -106,15 +105,42 @@ func (f *Filter) StableSize() (size int) {
 	return size
 }
 
-func (f *Filter) Unmarshal(data []byte) error {
-	m := new(netmap.Filter)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
+func (f *Filter) StableUnmarshal(data []byte) error {
+	if f == nil {
+		return nil
+	}
+
+	// inner filters are never nil as in FilterFromGRPCMessage
+	*f = Filter{
+		filters: []*Filter{},
 	}
 
-	*f = *FilterFromGRPCMessage(m)
+	return protoutil.UnmarshalFields(data, func(fld protoutil.Field) (err error) {
+		switch fld.Num {
+		case nameFilterField:
+			f.name, err = fld.StringValue()
+		case keyFilterField:
+			f.key, err = fld.StringValue()
+		case opFilterField:
+			var v int32
 
-	return nil
+			v, err = fld.Enum()
+			f.op = Operation(v)
+		case valueFilterField:
+			f.value, err = fld.StringValue()
+		case filtersFilterField:
+			v := new(Filter)
+			f.filters = append(f.filters, v)
+
+			err = fld.Nested(v)
+		}
+
+		return
+	})
+}
+
+func (f *Filter) Unmarshal(data []byte) error {
+	return f.StableUnmarshal(data)
 }
 
 func (s *Selector) StableMarshal(buf []byte) ([]byte, error) {
@@ -177,15 +203,36 @@ func (s *Selector) StableSize() (size int) {
 	return size
 }
 
-func (s *Selector) Unmarshal(data []byte) error {
-	m := new(netmap.Selector)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
-	}
+func (s *Selector) StableUnmarshal(data []byte) error {
+	if s == nil {
+		return nil
+	}
+
+	*s = Selector{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case nameSelectorField:
+			s.name, err = f.StringValue()
+		case countSelectorField:
+			s.count, err = f.UInt32()
+		case clauseSelectorField:
+			var v int32
+
+			v, err = f.Enum()
+			s.clause = Clause(v)
+		case attributeSelectorField:
+			s.attribute, err = f.StringValue()
+		case filterSelectorField:
+			s.filter, err = f.StringValue()
+		}
 
-	*s = *SelectorFromGRPCMessage(m)
+		return
+	})
+}
 
-	return nil
+func (s *Selector) Unmarshal(data []byte) error {
+	return s.StableUnmarshal(data)
 }
 
 func (r *Replica) StableMarshal(buf []byte) ([]byte, error) {
@@ -224,15 +271,27 @@ func (r *Replica) StableSize() (size int) {
 	return size
 }
 
-func (r *Replica) Unmarshal(data []byte) error {
-	m := new(netmap.Replica)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
+func (r *Replica) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
 	}
 
-	*r = *ReplicaFromGRPCMessage(m)
+	*r = Replica{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case countReplicaField:
+			r.count, err = f.UInt32()
+		case selectorReplicaField:
+			r.selector, err = f.StringValue()
+		}
+
+		return
+	})
+}
 
-	return nil
+func (r *Replica) Unmarshal(data []byte) error {
+	return r.StableUnmarshal(data)
 }
 
 func (p *PlacementPolicy) StableMarshal(buf []byte) ([]byte, error) {
@@ -304,15 +363,40 @@ func (p *PlacementPolicy) StableSize() (size int) {
 	return size
 }
 
-func (p *PlacementPolicy) Unmarshal(data []byte) error {
-	m := new(netmap.PlacementPolicy)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
+func (p *PlacementPolicy) StableUnmarshal(data []byte) error {
+	if p == nil {
+		return nil
 	}
 
-	*p = *PlacementPolicyFromGRPCMessage(m)
+	*p = PlacementPolicy{}
 
-	return nil
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case replicasPolicyField:
+			v := new(Replica)
+			p.replicas = append(p.replicas, v)
+
+			err = f.Nested(v)
+		case backupPolicyField:
+			p.backupFactor, err = f.UInt32()
+		case selectorsPolicyField:
+			v := new(Selector)
+			p.selectors = append(p.selectors, v)
+
+			err = f.Nested(v)
+		case filtersPolicyField:
+			v := new(Filter)
+			p.filters = append(p.filters, v)
+
+			err = f.Nested(v)
+		}
+
+		return
+	})
+}
+
+func (p *PlacementPolicy) Unmarshal(data []byte) error {
+	return p.StableUnmarshal(data)
 }
 
 func (a *Attribute) StableMarshal(buf []byte) ([]byte, error) {
@@ -370,15 +454,32 @@ func (a *Attribute) StableSize() (size int) {
 	return size
 }
 
-func (a *Attribute) Unmarshal(data []byte) error {
-	m := new(netmap.NodeInfo_Attribute)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
+func (a *Attribute) StableUnmarshal(data []byte) error {
+	if a == nil {
+		return nil
 	}
 
-	*a = *AttributeFromGRPCMessage(m)
+	*a = Attribute{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case keyAttributeField:
+			a.key, err = f.StringValue()
+		case valueAttributeField:
+			a.value, err = f.StringValue()
+		case parentsAttributeField:
+			var v string
+
+			v, err = f.StringValue()
+			a.parents = append(a.parents, v)
+		}
+
+		return
+	})
+}
 
-	return nil
+func (a *Attribute) Unmarshal(data []byte) error {
+	return a.StableUnmarshal(data)
 }
 
 func (ni *NodeInfo) StableMarshal(buf []byte) ([]byte, error) {
@@ -442,15 +543,37 @@ func (ni *NodeInfo) StableSize() (size int) {
 	return size
 }
 
-func (ni *NodeInfo) Unmarshal(data []byte) error {
-	m := new(netmap.NodeInfo)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
+func (ni *NodeInfo) StableUnmarshal(data []byte) error {
+	if ni == nil {
+		return nil
 	}
 
-	*ni = *NodeInfoFromGRPCMessage(m)
+	*ni = NodeInfo{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case keyNodeInfoField:
+			ni.publicKey, err = f.Bytes()
+		case addressNodeInfoField:
+			ni.address, err = f.StringValue()
+		case attributesNodeInfoField:
+			v := new(Attribute)
+			ni.attributes = append(ni.attributes, v)
+
+			err = f.Nested(v)
+		case stateNodeInfoField:
+			var v int32
+
+			v, err = f.Enum()
+			ni.state = NodeState(v)
+		}
 
-	return nil
+		return
+	})
+}
+
+func (ni *NodeInfo) Unmarshal(data []byte) error {
+	return ni.StableUnmarshal(data)
 }
 
 func (l *LocalNodeInfoRequestBody) StableMarshal(buf []byte) ([]byte, error) {
@@ -461,6 +584,22 @@ func (l *LocalNodeInfoRequestBody) StableSize() (size int) {
 	return 0
 }
 
+func (l *LocalNodeInfoRequestBody) StableUnmarshal(data []byte) error {
+	if l == nil {
+		return nil
+	}
+
+	*l = LocalNodeInfoRequestBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+
+		}
+
+		return
+	})
+}
+
 func (l *LocalNodeInfoResponseBody) StableMarshal(buf []byte) ([]byte, error) {
 	if l == nil {
 		return []byte{}, nil
@@ -501,6 +640,27 @@ func (l *LocalNodeInfoResponseBody) StableSize() (size int) {
 	return size
 }
 
+func (l *LocalNodeInfoResponseBody) StableUnmarshal(data []byte) error {
+	if l == nil {
+		return nil
+	}
+
+	*l = LocalNodeInfoResponseBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case versionInfoResponseBodyField:
+			l.version = new(refs.Version)
+			err = f.Nested(l.version)
+		case nodeInfoResponseBodyField:
+			l.nodeInfo = new(NodeInfo)
+			err = f.Nested(l.nodeInfo)
+		}
+
+		return
+	})
+}
+
 const (
 	_ = iota
 	netInfoCurEpochFNum
@@ -547,15 +707,27 @@ func (i *NetworkInfo) StableSize() (size int) {
 	return size
 }
 
-func (i *NetworkInfo) Unmarshal(data []byte) error {
-	m := new(netmap.NetworkInfo)
-	if err := proto.Unmarshal(data, m); err != nil {
-		return err
+func (i *NetworkInfo) StableUnmarshal(data []byte) error {
+	if i == nil {
+		return nil
 	}
 
-	*i = *NetworkInfoFromGRPCMessage(m)
+	*i = NetworkInfo{}
 
-	return nil
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case netInfoCurEpochFNum:
+			i.curEpoch, err = f.UInt64()
+		case netInfoMagicNumFNum:
+			i.magicNum, err = f.UInt64()
+		}
+
+		return
+	})
+}
+
+func (i *NetworkInfo) Unmarshal(data []byte) error {
+	return i.StableUnmarshal(data)
 }
 
 func (l *NetworkInfoRequestBody) StableMarshal(buf []byte) ([]byte, error) {
@@ -566,6 +738,22 @@ func (l *NetworkInfoRequestBody) StableSize() (size int) {
 	return 0
 }
 
+func (l *NetworkInfoRequestBody) StableUnmarshal(data []byte) error {
+	if l == nil {
+		return nil
+	}
+
+	*l = NetworkInfoRequestBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+
+		}
+
+		return
+	})
+}
+
 const (
 	_ = iota
 	netInfoRespBodyNetInfoFNum
@@ -597,3 +785,104 @@ func (i *NetworkInfoResponseBody) StableSize() (size int) {
 
 	return size
 }
+
+func (i *NetworkInfoResponseBody) StableUnmarshal(data []byte) error {
+	if i == nil {
+		return nil
+	}
+
+	*i = NetworkInfoResponseBody{}
+
+	return protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case netInfoRespBodyNetInfoFNum:
+			i.netInfo = new(NetworkInfo)
+			err = f.Nested(i.netInfo)
+		}
+
+		return
+	})
+}
+
+const (
+	epochNetmapField = 1
+	nodesNetmapField = 2
+)
+
+func (m *Netmap) StableMarshal(buf []byte) ([]byte, error) {
+	if m == nil {
+		return []byte{}, nil
+	}
+
+	if buf == nil {
+		buf = make([]byte, m.StableSize())
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = protoutil.UInt64Marshal(epochNetmapField, buf[offset:], m.epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	for i := range m.nodes {
+		n, err = protoutil.NestedStructureMarshal(nodesNetmapField, buf[offset:], m.nodes[i])
+		if err != nil {
+			return nil, err
+		}
+
+		offset += n
+	}
+
+	return buf, nil
+}
+
+func (m *Netmap) StableSize() (size int) {
+	if m == nil {
+		return 0
+	}
+
+	size += protoutil.UInt64Size(epochNetmapField, m.epoch)
+
+	for i := range m.nodes {
+		size += protoutil.NestedStructureSize(nodesNetmapField, m.nodes[i])
+	}
+
+	return size
+}
+
+func (m *Netmap) StableUnmarshal(data []byte) error {
+	if m == nil {
+		return nil
+	}
+
+	*m = Netmap{}
+
+	err := protoutil.UnmarshalFields(data, func(f protoutil.Field) (err error) {
+		switch f.Num {
+		case epochNetmapField:
+			m.epoch, err = f.UInt64()
+		case nodesNetmapField:
+			v := new(NodeInfo)
+			m.nodes = append(m.nodes, v)
+
+			err = f.Nested(v)
+		}
+
+		return
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.reindex()
+}
+
+func (m *Netmap) Unmarshal(data []byte) error {
+	return m.StableUnmarshal(data)
+}
@@ -108,6 +108,10 @@ func TestLocalNodeInfoResponseBody_StableMarshal(t *testing.T) {
 
 		to := netmap.LocalNodeInfoResponseBodyFromGRPCMessage(transport)
 		require.Equal(t, from, to)
+
+		to = new(netmap.LocalNodeInfoResponseBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, from, to)
 	})
 }
 
@@ -124,6 +128,10 @@ func TestNetworkInfoResponseBody_StableMarshal(t *testing.T) {
 
 		to := netmap.NetworkInfoResponseBodyFromGRPCMessage(transport)
 		require.Equal(t, from, to)
+
+		to = new(netmap.NetworkInfoResponseBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, from, to)
 	})
 }
 
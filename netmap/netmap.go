@@ -0,0 +1,201 @@
+package netmap
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// ErrDuplicateNode is returned when network map contains several nodes
+// with the same public key.
+var ErrDuplicateNode = errors.New("duplicate node")
+
+// Netmap is a snapshot of the network map at the epoch.
+//
+// Nodes are indexed by public key and kept ordered by it, so that
+// snapshots of the same network map are serialized identically.
+type Netmap struct {
+	epoch uint64
+
+	nodes []*NodeInfo
+
+	index map[string]int
+}
+
+// NodeChange describes the node which is present in both network maps
+// of the diff.
+type NodeChange struct {
+	// Old is the node in the previous network map.
+	Old *NodeInfo
+
+	// New is the node in the next network map.
+	New *NodeInfo
+}
+
+// NetmapDiff describes the changes between two network maps. All lists
+// are ordered by node public key.
+type NetmapDiff struct {
+	// Added contains nodes missing in the previous network map.
+	Added []*NodeInfo
+
+	// Removed contains nodes missing in the next network map.
+	Removed []*NodeInfo
+
+	// StateChanged contains nodes with changed state.
+	StateChanged []NodeChange
+
+	// AttributesChanged contains nodes with changed attributes.
+	AttributesChanged []NodeChange
+}
+
+// NewNetmap creates network map of the epoch with the nodes.
+//
+// Returns an error wrapping ErrDuplicateNode if public keys of nodes
+// are not unique, an error if any node has no public key.
+func NewNetmap(epoch uint64, nodes []*NodeInfo) (*Netmap, error) {
+	m := &Netmap{
+		epoch: epoch,
+		nodes: append([]*NodeInfo(nil), nodes...),
+	}
+
+	if err := m.reindex(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// reindex sorts nodes by public key and rebuilds the index.
+func (m *Netmap) reindex() error {
+	sort.SliceStable(m.nodes, func(i, j int) bool {
+		return bytes.Compare(m.nodes[i].GetPublicKey(), m.nodes[j].GetPublicKey()) < 0
+	})
+
+	m.index = make(map[string]int, len(m.nodes))
+
+	for i, ni := range m.nodes {
+		key := ni.GetPublicKey()
+		if len(key) == 0 {
+			return errors.New("node without public key")
+		}
+
+		if _, ok := m.index[string(key)]; ok {
+			return errors.Wrapf(ErrDuplicateNode, "public key %x", key)
+		}
+
+		m.index[string(key)] = i
+	}
+
+	return nil
+}
+
+// GetEpoch returns epoch of the network map.
+func (m *Netmap) GetEpoch() uint64 {
+	if m != nil {
+		return m.epoch
+	}
+
+	return 0
+}
+
+// GetNodes returns nodes of the network map ordered by public key.
+func (m *Netmap) GetNodes() []*NodeInfo {
+	if m != nil {
+		return m.nodes
+	}
+
+	return nil
+}
+
+// Node returns the node with the public key or nil if it is missing.
+func (m *Netmap) Node(key []byte) *NodeInfo {
+	if m == nil {
+		return nil
+	}
+
+	if i, ok := m.index[string(key)]; ok {
+		return m.nodes[i]
+	}
+
+	return nil
+}
+
+// Diff returns the changes of the next network map relative to m.
+func (m *Netmap) Diff(next *Netmap) *NetmapDiff {
+	d := new(NetmapDiff)
+
+	for _, ni := range m.GetNodes() {
+		nextNode := next.Node(ni.GetPublicKey())
+		if nextNode == nil {
+			d.Removed = append(d.Removed, ni)
+			continue
+		}
+
+		ch := NodeChange{
+			Old: ni,
+			New: nextNode,
+		}
+
+		if ni.GetState() != nextNode.GetState() {
+			d.StateChanged = append(d.StateChanged, ch)
+		}
+
+		if !equalAttributes(ni.GetAttributes(), nextNode.GetAttributes()) {
+			d.AttributesChanged = append(d.AttributesChanged, ch)
+		}
+	}
+
+	for _, ni := range next.GetNodes() {
+		if m.Node(ni.GetPublicKey()) == nil {
+			d.Added = append(d.Added, ni)
+		}
+	}
+
+	return d
+}
+
+// Empty checks whether the diff contains no changes.
+func (d *NetmapDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 &&
+		len(d.StateChanged) == 0 && len(d.AttributesChanged) == 0
+}
+
+// equalAttributes checks whether attribute lists are equal regardless
+// of the order.
+func equalAttributes(a, b []*Attribute) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	as, bs := sortedAttributes(a), sortedAttributes(b)
+
+	for i := range as {
+		if as[i].GetKey() != bs[i].GetKey() || as[i].GetValue() != bs[i].GetValue() {
+			return false
+		}
+
+		pa, pb := as[i].GetParents(), bs[i].GetParents()
+		if len(pa) != len(pb) {
+			return false
+		}
+
+		for j := range pa {
+			if pa[j] != pb[j] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func sortedAttributes(as []*Attribute) []*Attribute {
+	res := append([]*Attribute(nil), as...)
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].GetKey() < res[j].GetKey()
+	})
+
+	return res
+}
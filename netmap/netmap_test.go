@@ -0,0 +1,117 @@
+package netmap_test
+
+import (
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/netmap"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNetmap(t *testing.T) {
+	a, b := generateNodeInfo("a", "/a", 1), generateNodeInfo("b", "/b", 2)
+
+	m, err := netmap.NewNetmap(10, []*netmap.NodeInfo{b, a})
+	require.NoError(t, err)
+	require.EqualValues(t, 10, m.GetEpoch())
+	require.Equal(t, []*netmap.NodeInfo{a, b}, m.GetNodes())
+	require.Equal(t, b, m.Node([]byte("b")))
+	require.Nil(t, m.Node([]byte("c")))
+
+	_, err = netmap.NewNetmap(10, []*netmap.NodeInfo{a, b, generateNodeInfo("a", "/c", 0)})
+	require.True(t, errors.Is(err, netmap.ErrDuplicateNode))
+
+	_, err = netmap.NewNetmap(10, []*netmap.NodeInfo{new(netmap.NodeInfo)})
+	require.Error(t, err)
+}
+
+func TestNetmap_Diff(t *testing.T) {
+	prev, err := netmap.NewNetmap(1, []*netmap.NodeInfo{
+		generateNodeInfo("a", "/a", 1),
+		generateNodeInfo("b", "/b", 1),
+		generateNodeInfo("c", "/c", 1),
+		generateNodeInfo("d", "/d", 2),
+	})
+	require.NoError(t, err)
+
+	require.True(t, prev.Diff(prev).Empty())
+
+	b := generateNodeInfo("b", "/b", 1)
+	b.SetState(netmap.Offline)
+
+	c := generateNodeInfo("c", "/c", 1)
+	c.GetAttributes()[0].SetValue("other")
+
+	d := generateNodeInfo("d", "/d", 2)
+	attrs := d.GetAttributes()
+	d.SetAttributes([]*netmap.Attribute{attrs[1], attrs[0]})
+
+	e := generateNodeInfo("e", "/e", 0)
+
+	next, err := netmap.NewNetmap(2, []*netmap.NodeInfo{e, d, c, b})
+	require.NoError(t, err)
+
+	diff := prev.Diff(next)
+	require.False(t, diff.Empty())
+	require.Equal(t, []*netmap.NodeInfo{e}, diff.Added)
+	require.Equal(t, []*netmap.NodeInfo{prev.Node([]byte("a"))}, diff.Removed)
+	require.Equal(t, []netmap.NodeChange{{Old: prev.Node([]byte("b")), New: b}}, diff.StateChanged)
+	require.Equal(t, []netmap.NodeChange{{Old: prev.Node([]byte("c")), New: c}}, diff.AttributesChanged)
+}
+
+func TestNetmap_StableMarshal(t *testing.T) {
+	from, err := netmap.NewNetmap(10, []*netmap.NodeInfo{
+		generateNodeInfo("b", "/b", 2),
+		generateNodeInfo("a", "/a", 1),
+	})
+	require.NoError(t, err)
+
+	wire, err := from.StableMarshal(nil)
+	require.NoError(t, err)
+
+	to := new(netmap.Netmap)
+	require.NoError(t, to.Unmarshal(wire))
+	require.Equal(t, from, to)
+
+	again, err := to.StableMarshal(nil)
+	require.NoError(t, err)
+	require.Equal(t, wire, again)
+
+	dup := generateNodeInfo("a", "/a", 1)
+
+	data, err := dup.StableMarshal(nil)
+	require.NoError(t, err)
+
+	// append one more node with the same key
+	wire = append(wire, 0x12, byte(len(data)))
+	wire = append(wire, data...)
+
+	require.True(t, errors.Is(to.Unmarshal(wire), netmap.ErrDuplicateNode))
+}
+
+func TestNetmapJSON(t *testing.T) {
+	from, err := netmap.NewNetmap(10, []*netmap.NodeInfo{
+		generateNodeInfo("b", "/b", 2),
+		generateNodeInfo("a", "/a", 1),
+	})
+	require.NoError(t, err)
+
+	data, err := from.MarshalJSON()
+	require.NoError(t, err)
+
+	to := new(netmap.Netmap)
+	require.NoError(t, to.UnmarshalJSON(data))
+	require.Equal(t, from, to)
+
+	again, err := to.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, data, again)
+
+	var empty *netmap.Netmap
+
+	data, err = empty.MarshalJSON()
+	require.NoError(t, err)
+	require.NoError(t, to.UnmarshalJSON(data))
+	require.Zero(t, to.GetEpoch())
+	require.Empty(t, to.GetNodes())
+}
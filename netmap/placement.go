@@ -0,0 +1,483 @@
+package netmap
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"strconv"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/pkg/errors"
+)
+
+// MainFilterName is a name of the filter which selects all nodes
+// of the network map.
+const MainFilterName = "*"
+
+var (
+	// ErrFilterNotFound is returned when the filter referenced in
+	// the placement policy is missing.
+	ErrFilterNotFound = errors.New("filter not found")
+
+	// ErrSelectorNotFound is returned when the selector referenced in
+	// the placement policy is missing.
+	ErrSelectorNotFound = errors.New("selector not found")
+
+	// ErrInvalidFilter is returned when the filter of the placement
+	// policy is malformed.
+	ErrInvalidFilter = errors.New("invalid filter")
+
+	// ErrInvalidSelector is returned when the selector of the placement
+	// policy is malformed.
+	ErrInvalidSelector = errors.New("invalid selector")
+
+	// ErrNotEnoughNodes is returned when the network map does not
+	// contain enough nodes to satisfy the selector.
+	ErrNotEnoughNodes = errors.New("not enough nodes")
+)
+
+// matcher checks whether the node with the attributes passes the filter.
+type matcher func(map[string]string) bool
+
+type placementNode struct {
+	info *NodeInfo
+
+	attrs map[string]string
+
+	weight uint64
+}
+
+type placementContext struct {
+	policy *PlacementPolicy
+
+	pivot []byte
+
+	nodes []*placementNode
+
+	filters map[string]*Filter
+
+	matchers map[string]matcher
+
+	selectors map[string]*Selector
+
+	selections map[string][][]*placementNode
+}
+
+// ContainerNodes returns the node vectors of the network map which store
+// the container according to the placement policy, one vector per replica.
+//
+// Offline nodes are ignored. Each selector takes the number of nodes
+// multiplied by the container backup factor if there are enough suitable
+// nodes, otherwise the factor of 1 is used. Zero backup factor is
+// treated as 1.
+//
+// Result is deterministic: nodes and buckets of equal attribute values
+// are ordered by rendezvous hash of the node public key and the
+// container ID. If container ID is not set, public keys are compared.
+//
+// Returns an error wrapping ErrFilterNotFound, ErrSelectorNotFound,
+// ErrInvalidFilter, ErrInvalidSelector or ErrNotEnoughNodes on failure.
+func ContainerNodes(p *PlacementPolicy, nodes []*NodeInfo, cid *refs.ContainerID) ([][]*NodeInfo, error) {
+	c := &placementContext{
+		policy:     p,
+		pivot:      cid.GetValue(),
+		filters:    make(map[string]*Filter),
+		matchers:   make(map[string]matcher),
+		selectors:  make(map[string]*Selector),
+		selections: make(map[string][][]*placementNode),
+	}
+
+	for _, ni := range nodes {
+		if ni.GetState() == Offline {
+			continue
+		}
+
+		c.nodes = append(c.nodes, newPlacementNode(ni, c.pivot))
+	}
+
+	sortPlacementNodes(c.nodes)
+
+	if err := c.processFilters(); err != nil {
+		return nil, err
+	}
+
+	if err := c.processSelectors(); err != nil {
+		return nil, err
+	}
+
+	replicas := p.GetReplicas()
+	res := make([][]*NodeInfo, 0, len(replicas))
+
+	for i, r := range replicas {
+		v, err := c.replicaNodes(r)
+		if err != nil {
+			return nil, errors.Wrapf(err, "replica #%d", i)
+		}
+
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// ObjectNodes returns copies of container node vectors ordered by
+// rendezvous hash of the node public key and the object ID.
+func ObjectNodes(vectors [][]*NodeInfo, oid *refs.ObjectID) [][]*NodeInfo {
+	pivot := oid.GetValue()
+	res := make([][]*NodeInfo, 0, len(vectors))
+
+	for _, v := range vectors {
+		ns := make([]*placementNode, 0, len(v))
+
+		for _, ni := range v {
+			ns = append(ns, &placementNode{
+				info:   ni,
+				weight: nodeWeight(ni.GetPublicKey(), pivot),
+			})
+		}
+
+		sortPlacementNodes(ns)
+
+		res = append(res, placementInfos(ns))
+	}
+
+	return res
+}
+
+func newPlacementNode(ni *NodeInfo, pivot []byte) *placementNode {
+	attrs := make(map[string]string, len(ni.GetAttributes()))
+
+	for _, a := range ni.GetAttributes() {
+		attrs[a.GetKey()] = a.GetValue()
+	}
+
+	return &placementNode{
+		info:   ni,
+		attrs:  attrs,
+		weight: nodeWeight(ni.GetPublicKey(), pivot),
+	}
+}
+
+// nodeWeight returns rendezvous hash weight of the node for the pivot.
+func nodeWeight(key, pivot []byte) uint64 {
+	h := sha256.New()
+	_, _ = h.Write(key)
+	_, _ = h.Write(pivot)
+
+	return binary.BigEndian.Uint64(h.Sum(nil))
+}
+
+// sortPlacementNodes sorts nodes by descending weight, ties are broken
+// by public keys.
+func sortPlacementNodes(ns []*placementNode) {
+	sort.SliceStable(ns, func(i, j int) bool {
+		if ns[i].weight != ns[j].weight {
+			return ns[i].weight > ns[j].weight
+		}
+
+		return bytes.Compare(ns[i].info.GetPublicKey(), ns[j].info.GetPublicKey()) < 0
+	})
+}
+
+func placementInfos(ns []*placementNode) []*NodeInfo {
+	res := make([]*NodeInfo, 0, len(ns))
+
+	for _, n := range ns {
+		res = append(res, n.info)
+	}
+
+	return res
+}
+
+func (c *placementContext) processFilters() error {
+	for _, f := range c.policy.GetFilters() {
+		name := f.GetName()
+
+		switch {
+		case name == "":
+			return errors.Wrap(ErrInvalidFilter, "unnamed top-level filter")
+		case name == MainFilterName:
+			return errors.Wrapf(ErrInvalidFilter, "reserved filter name %s", name)
+		}
+
+		if _, ok := c.filters[name]; ok {
+			return errors.Wrapf(ErrInvalidFilter, "duplicate filter %s", name)
+		}
+
+		c.filters[name] = f
+	}
+
+	for _, f := range c.policy.GetFilters() {
+		if _, err := c.namedMatcher(f.GetName(), nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// namedMatcher returns matcher of the top-level filter. Stack contains
+// the names of the filters being compiled and is used to detect cycles.
+func (c *placementContext) namedMatcher(name string, stack []string) (matcher, error) {
+	if m, ok := c.matchers[name]; ok {
+		return m, nil
+	}
+
+	for i := range stack {
+		if stack[i] == name {
+			return nil, errors.Wrapf(ErrInvalidFilter, "cyclic reference to filter %s", name)
+		}
+	}
+
+	f, ok := c.filters[name]
+	if !ok {
+		return nil, errors.Wrap(ErrFilterNotFound, name)
+	}
+
+	m, err := c.compileFilter(f, append(stack, name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "filter %s", name)
+	}
+
+	c.matchers[name] = m
+
+	return m, nil
+}
+
+func (c *placementContext) compileFilter(f *Filter, stack []string) (matcher, error) {
+	switch op := f.GetOp(); op {
+	case AND, OR:
+		inner := f.GetFilters()
+		ms := make([]matcher, 0, len(inner))
+
+		for _, fi := range inner {
+			var (
+				m   matcher
+				err error
+			)
+
+			// named inner filter is a reference to the top-level one
+			if name := fi.GetName(); name != "" {
+				if fi.GetKey() != "" || fi.GetOp() != UnspecifiedOperation ||
+					fi.GetValue() != "" || len(fi.GetFilters()) != 0 {
+					return nil, errors.Wrapf(ErrInvalidFilter, "reference to filter %s has own definition", name)
+				}
+
+				m, err = c.namedMatcher(name, stack)
+			} else {
+				m, err = c.compileFilter(fi, stack)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			ms = append(ms, m)
+		}
+
+		isOr := op == OR
+
+		return func(attrs map[string]string) bool {
+			for _, m := range ms {
+				if m(attrs) == isOr {
+					return isOr
+				}
+			}
+
+			return !isOr
+		}, nil
+	case EQ:
+		return func(attrs map[string]string) bool {
+			return attrs[f.GetKey()] == f.GetValue()
+		}, nil
+	case NE:
+		return func(attrs map[string]string) bool {
+			return attrs[f.GetKey()] != f.GetValue()
+		}, nil
+	case GT, GE, LT, LE:
+		val, err := strconv.ParseUint(f.GetValue(), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidFilter, "invalid numeric value %q", f.GetValue())
+		}
+
+		key := f.GetKey()
+
+		return func(attrs map[string]string) bool {
+			v, err := strconv.ParseUint(attrs[key], 10, 64)
+			if err != nil {
+				return false
+			}
+
+			switch op {
+			case GT:
+				return v > val
+			case GE:
+				return v >= val
+			case LT:
+				return v < val
+			default:
+				return v <= val
+			}
+		}, nil
+	default:
+		return nil, errors.Wrapf(ErrInvalidFilter, "unsupported operation %d", op)
+	}
+}
+
+func (c *placementContext) processSelectors() error {
+	for _, s := range c.policy.GetSelectors() {
+		if _, ok := c.selectors[s.GetName()]; ok {
+			return errors.Wrapf(ErrInvalidSelector, "duplicate selector %s", s.GetName())
+		}
+
+		c.selectors[s.GetName()] = s
+
+		sel, err := c.selection(s)
+		if err != nil {
+			return errors.Wrapf(err, "selector %s", s.GetName())
+		}
+
+		c.selections[s.GetName()] = sel
+	}
+
+	return nil
+}
+
+// selection returns the buckets of nodes selected by the selector.
+func (c *placementContext) selection(s *Selector) ([][]*placementNode, error) {
+	if s.GetCount() == 0 {
+		return nil, errors.Wrap(ErrInvalidSelector, "zero node count")
+	}
+
+	bucketCount, nodesInBucket := int(s.GetCount()), 1
+	if s.GetClause() == Same {
+		bucketCount, nodesInBucket = 1, int(s.GetCount())
+	}
+
+	cbf := int(c.policy.GetContainerBackupFactor())
+	if cbf == 0 {
+		cbf = 1
+	}
+
+	buckets, err := c.buckets(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var full [][]*placementNode
+
+	for _, b := range buckets {
+		if len(b) >= nodesInBucket*cbf {
+			full = append(full, b[:nodesInBucket*cbf])
+		}
+	}
+
+	if len(full) >= bucketCount {
+		return full[:bucketCount], nil
+	}
+
+	// fallback to the minimal backup factor
+	res := make([][]*placementNode, 0, len(buckets))
+
+	for _, b := range buckets {
+		if len(b) >= nodesInBucket {
+			res = append(res, b[:nodesInBucket])
+		}
+	}
+
+	if len(res) < bucketCount {
+		return nil, errors.Wrapf(ErrNotEnoughNodes, "need %d buckets of %d nodes, got %d",
+			bucketCount, nodesInBucket, len(res))
+	}
+
+	return res[:bucketCount], nil
+}
+
+// buckets returns filtered nodes grouped by the selector attribute.
+// Buckets are ordered by the weight of their first node.
+func (c *placementContext) buckets(s *Selector) ([][]*placementNode, error) {
+	match := func(map[string]string) bool { return true }
+
+	if name := s.GetFilter(); name != MainFilterName {
+		m, ok := c.matchers[name]
+		if !ok {
+			return nil, errors.Wrap(ErrFilterNotFound, name)
+		}
+
+		match = m
+	}
+
+	var (
+		res   [][]*placementNode
+		index = make(map[string]int)
+		attr  = s.GetAttribute()
+	)
+
+	for _, n := range c.nodes {
+		if !match(n.attrs) {
+			continue
+		}
+
+		if attr == "" && s.GetClause() != Same {
+			// each node is a separate bucket
+			res = append(res, []*placementNode{n})
+			continue
+		}
+
+		v, ok := n.attrs[attr]
+		if !ok && attr != "" {
+			continue
+		}
+
+		i, ok := index[v]
+		if !ok {
+			i = len(res)
+			index[v] = i
+			res = append(res, nil)
+		}
+
+		res[i] = append(res[i], n)
+	}
+
+	return res, nil
+}
+
+func (c *placementContext) replicaNodes(r *Replica) ([]*NodeInfo, error) {
+	if r.GetCount() == 0 {
+		return nil, errors.New("zero replica count")
+	}
+
+	var buckets [][]*placementNode
+
+	if name := r.GetSelector(); name != "" {
+		sel, ok := c.selections[name]
+		if !ok {
+			return nil, errors.Wrap(ErrSelectorNotFound, name)
+		}
+
+		buckets = sel
+	} else if len(c.policy.GetSelectors()) == 0 {
+		sel, err := c.selection(&Selector{
+			count:  r.GetCount(),
+			filter: MainFilterName,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		buckets = sel
+	} else {
+		for _, s := range c.policy.GetSelectors() {
+			buckets = append(buckets, c.selections[s.GetName()]...)
+		}
+	}
+
+	var res []*NodeInfo
+
+	for _, b := range buckets {
+		res = append(res, placementInfos(b)...)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,297 @@
+package netmap_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/netmap"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func newNode(i int, attrs ...string) *netmap.NodeInfo {
+	ni := new(netmap.NodeInfo)
+	ni.SetPublicKey([]byte{byte(i)})
+	ni.SetState(netmap.Online)
+
+	as := make([]*netmap.Attribute, 0, len(attrs)/2)
+
+	for j := 0; j < len(attrs); j += 2 {
+		a := new(netmap.Attribute)
+		a.SetKey(attrs[j])
+		a.SetValue(attrs[j+1])
+
+		as = append(as, a)
+	}
+
+	ni.SetAttributes(as)
+
+	return ni
+}
+
+func newFilter(name, key string, op netmap.Operation, value string, fs ...*netmap.Filter) *netmap.Filter {
+	f := new(netmap.Filter)
+	f.SetName(name)
+	f.SetKey(key)
+	f.SetOp(op)
+	f.SetValue(value)
+	f.SetFilters(fs)
+
+	return f
+}
+
+func newSelector(name, attr string, c netmap.Clause, count uint32, filter string) *netmap.Selector {
+	s := new(netmap.Selector)
+	s.SetName(name)
+	s.SetAttribute(attr)
+	s.SetClause(c)
+	s.SetCount(count)
+	s.SetFilter(filter)
+
+	return s
+}
+
+func newReplica(count uint32, selector string) *netmap.Replica {
+	r := new(netmap.Replica)
+	r.SetCount(count)
+	r.SetSelector(selector)
+
+	return r
+}
+
+func newPolicy(cbf uint32, rs []*netmap.Replica, ss []*netmap.Selector, fs []*netmap.Filter) *netmap.PlacementPolicy {
+	p := new(netmap.PlacementPolicy)
+	p.SetContainerBackupFactor(cbf)
+	p.SetReplicas(rs)
+	p.SetSelectors(ss)
+	p.SetFilters(fs)
+
+	return p
+}
+
+func testNodes() []*netmap.NodeInfo {
+	countries := []string{"RU", "RU", "DE", "DE", "FR", "FR", "RU", "DE"}
+
+	nodes := make([]*netmap.NodeInfo, 0, len(countries))
+
+	for i := range countries {
+		nodes = append(nodes, newNode(i,
+			"Country", countries[i],
+			"Rating", strconv.Itoa(i),
+		))
+	}
+
+	return nodes
+}
+
+func nodeAttr(ni *netmap.NodeInfo, key string) string {
+	for _, a := range ni.GetAttributes() {
+		if a.GetKey() == key {
+			return a.GetValue()
+		}
+	}
+
+	return ""
+}
+
+func testCID() *refs.ContainerID {
+	cid := new(refs.ContainerID)
+	cid.SetValue([]byte("container"))
+
+	return cid
+}
+
+func TestContainerNodes(t *testing.T) {
+	nodes := testNodes()
+
+	t.Run("same and distinct", func(t *testing.T) {
+		p := newPolicy(1,
+			[]*netmap.Replica{newReplica(2, "S"), newReplica(3, "D")},
+			[]*netmap.Selector{
+				newSelector("S", "Country", netmap.Same, 2, "Good"),
+				newSelector("D", "Country", netmap.Distinct, 3, netmap.MainFilterName),
+			},
+			[]*netmap.Filter{
+				newFilter("Good", "Rating", netmap.GE, "2"),
+			},
+		)
+
+		vs, err := netmap.ContainerNodes(p, nodes, testCID())
+		require.NoError(t, err)
+		require.Len(t, vs, 2)
+
+		require.Len(t, vs[0], 2)
+		require.Equal(t, nodeAttr(vs[0][0], "Country"), nodeAttr(vs[0][1], "Country"))
+
+		for _, n := range vs[0] {
+			r, err := strconv.Atoi(nodeAttr(n, "Rating"))
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, r, 2)
+		}
+
+		require.Len(t, vs[1], 3)
+
+		seen := make(map[string]struct{})
+		for _, n := range vs[1] {
+			seen[nodeAttr(n, "Country")] = struct{}{}
+		}
+
+		require.Len(t, seen, 3)
+
+		again, err := netmap.ContainerNodes(p, nodes, testCID())
+		require.NoError(t, err)
+		require.Equal(t, vs, again)
+	})
+
+	t.Run("nested filters", func(t *testing.T) {
+		p := newPolicy(0,
+			[]*netmap.Replica{newReplica(1, "")},
+			[]*netmap.Selector{newSelector("X", "", netmap.Distinct, 3, "F")},
+			[]*netmap.Filter{
+				newFilter("NotRU", "Country", netmap.NE, "RU"),
+				newFilter("F", "", netmap.OR, "",
+					newFilter("", "", netmap.AND, "",
+						newFilter("NotRU", "", netmap.UnspecifiedOperation, ""),
+						newFilter("", "Rating", netmap.LT, "4"),
+					),
+					newFilter("", "Rating", netmap.GT, "6"),
+				),
+			},
+		)
+
+		vs, err := netmap.ContainerNodes(p, nodes, testCID())
+		require.NoError(t, err)
+		require.Len(t, vs, 1)
+		require.Len(t, vs[0], 3)
+
+		keys := make(map[byte]struct{})
+		for _, n := range vs[0] {
+			keys[n.GetPublicKey()[0]] = struct{}{}
+		}
+
+		require.Equal(t, map[byte]struct{}{2: {}, 3: {}, 7: {}}, keys)
+	})
+
+	t.Run("backup factor", func(t *testing.T) {
+		p := newPolicy(2,
+			[]*netmap.Replica{newReplica(2, "X")},
+			[]*netmap.Selector{newSelector("X", "Country", netmap.Distinct, 2, netmap.MainFilterName)},
+			nil,
+		)
+
+		vs, err := netmap.ContainerNodes(p, nodes, testCID())
+		require.NoError(t, err)
+		require.Len(t, vs[0], 4)
+
+		p.SetContainerBackupFactor(4)
+
+		vs, err = netmap.ContainerNodes(p, nodes, testCID())
+		require.NoError(t, err)
+		require.Len(t, vs[0], 2)
+	})
+
+	t.Run("offline", func(t *testing.T) {
+		ns := []*netmap.NodeInfo{newNode(0), newNode(1)}
+		ns[1].SetState(netmap.Offline)
+
+		p := newPolicy(0, []*netmap.Replica{newReplica(2, "")}, nil, nil)
+
+		_, err := netmap.ContainerNodes(p, ns, testCID())
+		require.True(t, errors.Is(err, netmap.ErrNotEnoughNodes))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, tc := range []struct {
+			name   string
+			policy *netmap.PlacementPolicy
+			err    error
+		}{
+			{
+				name: "missing selector",
+				policy: newPolicy(0,
+					[]*netmap.Replica{newReplica(1, "X")}, nil, nil),
+				err: netmap.ErrSelectorNotFound,
+			},
+			{
+				name: "missing filter",
+				policy: newPolicy(0,
+					[]*netmap.Replica{newReplica(1, "X")},
+					[]*netmap.Selector{newSelector("X", "", netmap.Distinct, 1, "F")},
+					nil),
+				err: netmap.ErrFilterNotFound,
+			},
+			{
+				name: "cycle",
+				policy: newPolicy(0, nil, nil, []*netmap.Filter{
+					newFilter("A", "", netmap.AND, "", newFilter("B", "", 0, "")),
+					newFilter("B", "", netmap.OR, "", newFilter("A", "", 0, "")),
+				}),
+				err: netmap.ErrInvalidFilter,
+			},
+			{
+				name: "reference with definition",
+				policy: newPolicy(0, nil, nil, []*netmap.Filter{
+					newFilter("A", "Country", netmap.EQ, "Germany"),
+					newFilter("B", "", netmap.AND, "",
+						newFilter("A", "Country", netmap.EQ, "France"),
+						newFilter("", "Rating", netmap.GT, "1"),
+					),
+				}),
+				err: netmap.ErrInvalidFilter,
+			},
+			{
+				name: "duplicate selector",
+				policy: newPolicy(0,
+					[]*netmap.Replica{newReplica(1, "X")},
+					[]*netmap.Selector{
+						newSelector("X", "", netmap.Distinct, 1, netmap.MainFilterName),
+						newSelector("X", "", netmap.Distinct, 2, netmap.MainFilterName),
+					},
+					nil),
+				err: netmap.ErrInvalidSelector,
+			},
+			{
+				name: "zero node count",
+				policy: newPolicy(0,
+					[]*netmap.Replica{newReplica(1, "X")},
+					[]*netmap.Selector{newSelector("X", "", netmap.Distinct, 0, netmap.MainFilterName)},
+					nil),
+				err: netmap.ErrInvalidSelector,
+			},
+			{
+				name: "invalid number",
+				policy: newPolicy(0, nil, nil, []*netmap.Filter{
+					newFilter("A", "Rating", netmap.GT, "high"),
+				}),
+				err: netmap.ErrInvalidFilter,
+			},
+			{
+				name: "not enough nodes",
+				policy: newPolicy(0,
+					[]*netmap.Replica{newReplica(1, "X")},
+					[]*netmap.Selector{newSelector("X", "Country", netmap.Distinct, 4, netmap.MainFilterName)},
+					nil),
+				err: netmap.ErrNotEnoughNodes,
+			},
+		} {
+			_, err := netmap.ContainerNodes(tc.policy, nodes, testCID())
+			require.True(t, errors.Is(err, tc.err), tc.name)
+		}
+	})
+}
+
+func TestObjectNodes(t *testing.T) {
+	p := newPolicy(0, []*netmap.Replica{newReplica(3, "")}, nil, nil)
+
+	vs, err := netmap.ContainerNodes(p, testNodes(), testCID())
+	require.NoError(t, err)
+
+	oid := new(refs.ObjectID)
+	oid.SetValue([]byte("object"))
+
+	ovs := netmap.ObjectNodes(vs, oid)
+	require.Len(t, ovs, 1)
+	require.ElementsMatch(t, vs[0], ovs[0])
+	require.Equal(t, ovs, netmap.ObjectNodes(vs, oid))
+}
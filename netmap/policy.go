@@ -0,0 +1,730 @@
+package netmap
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// PolicySyntaxError describes the failure of placement policy parsing.
+type PolicySyntaxError struct {
+	// Line is a 1-based line number of the failing character.
+	Line int
+
+	// Column is a 1-based position of the failing character in the line.
+	Column int
+
+	// Msg describes the failure.
+	Msg string
+}
+
+func (e *PolicySyntaxError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Msg)
+}
+
+const (
+	policyRep      = "REP"
+	policyIn       = "IN"
+	policyCBF      = "CBF"
+	policySelect   = "SELECT"
+	policyFrom     = "FROM"
+	policyAs       = "AS"
+	policyFilter   = "FILTER"
+	policySame     = "SAME"
+	policyDistinct = "DISTINCT"
+	policyAnd      = "AND"
+	policyOr       = "OR"
+)
+
+var policyKeywords = map[string]struct{}{
+	policyRep:      {},
+	policyIn:       {},
+	policyCBF:      {},
+	policySelect:   {},
+	policyFrom:     {},
+	policyAs:       {},
+	policyFilter:   {},
+	policySame:     {},
+	policyDistinct: {},
+	policyAnd:      {},
+	policyOr:       {},
+}
+
+// text forms of the simple filter operations
+var policyOperations = []struct {
+	name string
+	op   Operation
+}{
+	{name: "EQ", op: EQ},
+	{name: "NE", op: NE},
+	{name: "GT", op: GT},
+	{name: "GE", op: GE},
+	{name: "LT", op: LT},
+	{name: "LE", op: LE},
+}
+
+type policyTokenType uint8
+
+const (
+	policyTokenEOF policyTokenType = iota
+	policyTokenWord
+	policyTokenString
+	policyTokenLParen
+	policyTokenRParen
+	policyTokenAll
+	policyTokenRef
+)
+
+type policyToken struct {
+	typ policyTokenType
+
+	// value of the word or unquoted string
+	val string
+
+	// byte offset in the text
+	pos int
+}
+
+type policyParser struct {
+	s string
+
+	pos int
+
+	tok policyToken
+}
+
+// ParsePlacementPolicy parses placement policy from the text.
+//
+// Policy is a sequence of statements:
+//
+//	REP 1 IN X
+//	REP 2
+//	CBF 2
+//	SELECT 2 IN SAME Country FROM F AS X
+//	FILTER Country EQ RU AND (Rating GE 4 OR @Trusted) AS F
+//	FILTER Trusted EQ true AS Trusted
+//
+// Statements are:
+//   - one or more REP count [IN selector] replicas;
+//   - optional CBF factor container backup factor;
+//   - SELECT count [IN [SAME|DISTINCT] [attribute]] FROM (filter|*) [AS name]
+//     selectors;
+//   - FILTER expression AS name filters.
+//
+// Filter expression consists of simple filters key op value with
+// op one of EQ, NE, GT, GE, LT and LE, references @name to other filters
+// and parenthesized expressions joined with AND and OR. AND has higher
+// precedence. Consecutive operands of the same operation are joined into
+// single filter, parenthesized expression is always a separate one.
+//
+// Names, keys and values are either bare words or double-quoted strings
+// with Go escape sequences. Keywords are case-sensitive and must be quoted
+// to be used as names.
+//
+// Returns *PolicySyntaxError if text is malformed.
+func ParsePlacementPolicy(s string) (*PlacementPolicy, error) {
+	p := &policyParser{s: s}
+
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	policy := new(PlacementPolicy)
+
+	for p.isKeyword(policyRep) {
+		r, err := p.parseReplica()
+		if err != nil {
+			return nil, err
+		}
+
+		policy.replicas = append(policy.replicas, r)
+	}
+
+	if len(policy.replicas) == 0 {
+		return nil, p.errorf(p.tok.pos, "expected %s", policyRep)
+	}
+
+	if p.isKeyword(policyCBF) {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		cbf, err := p.parseCount("backup factor")
+		if err != nil {
+			return nil, err
+		}
+
+		policy.backupFactor = cbf
+	}
+
+	for p.isKeyword(policySelect) {
+		s, err := p.parseSelector()
+		if err != nil {
+			return nil, err
+		}
+
+		policy.selectors = append(policy.selectors, s)
+	}
+
+	for p.isKeyword(policyFilter) {
+		f, err := p.parseFilterStatement()
+		if err != nil {
+			return nil, err
+		}
+
+		policy.filters = append(policy.filters, f)
+	}
+
+	if p.tok.typ != policyTokenEOF {
+		return nil, p.errorf(p.tok.pos, "expected %s, %s or end of policy", policySelect, policyFilter)
+	}
+
+	return policy, nil
+}
+
+func (p *policyParser) parseReplica() (*Replica, error) {
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	r := new(Replica)
+
+	count, err := p.parseCount("replica count")
+	if err != nil {
+		return nil, err
+	}
+
+	r.count = count
+
+	if p.isKeyword(policyIn) {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		if r.selector, err = p.parseName("selector name"); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+func (p *policyParser) parseSelector() (*Selector, error) {
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	s := new(Selector)
+
+	count, err := p.parseCount("node count")
+	if err != nil {
+		return nil, err
+	}
+
+	s.count = count
+
+	if p.isKeyword(policyIn) {
+		pos := p.tok.pos
+
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		switch {
+		case p.isKeyword(policySame):
+			s.clause = Same
+		case p.isKeyword(policyDistinct):
+			s.clause = Distinct
+		}
+
+		if s.clause != UnspecifiedClause {
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+		}
+
+		if !p.isKeyword(policyFrom) {
+			if s.attribute, err = p.parseName("attribute"); err != nil {
+				return nil, err
+			}
+		} else if s.clause == UnspecifiedClause {
+			return nil, p.errorf(pos, "expected clause or attribute after %s", policyIn)
+		}
+	}
+
+	if !p.isKeyword(policyFrom) {
+		return nil, p.errorf(p.tok.pos, "expected %s", policyFrom)
+	}
+
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	if p.tok.typ == policyTokenAll {
+		s.filter = MainFilterName
+
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+	} else if s.filter, err = p.parseName("filter name"); err != nil {
+		return nil, err
+	}
+
+	if p.isKeyword(policyAs) {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		if s.name, err = p.parseName("selector name"); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+func (p *policyParser) parseFilterStatement() (*Filter, error) {
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	pos := p.tok.pos
+
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if f.op == UnspecifiedOperation {
+		return nil, p.errorf(pos, "filter reference can not be a filter definition")
+	}
+
+	if !p.isKeyword(policyAs) {
+		return nil, p.errorf(p.tok.pos, "expected %s", policyAs)
+	}
+
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	if f.name, err = p.parseName("filter name"); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (p *policyParser) parseOr() (*Filter, error) {
+	return p.parseChain(OR, policyOr, p.parseAnd)
+}
+
+func (p *policyParser) parseAnd() (*Filter, error) {
+	return p.parseChain(AND, policyAnd, p.parseTerm)
+}
+
+// parseChain parses operands joined with the keyword into single filter
+// with the operation.
+func (p *policyParser) parseChain(op Operation, keyword string, operand func() (*Filter, error)) (*Filter, error) {
+	f, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.isKeyword(keyword) {
+		return f, nil
+	}
+
+	res := &Filter{
+		op:      op,
+		filters: []*Filter{f},
+	}
+
+	for p.isKeyword(keyword) {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		f, err := operand()
+		if err != nil {
+			return nil, err
+		}
+
+		res.filters = append(res.filters, f)
+	}
+
+	return res, nil
+}
+
+func (p *policyParser) parseTerm() (*Filter, error) {
+	switch p.tok.typ {
+	case policyTokenLParen:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.tok.typ != policyTokenRParen {
+			return nil, p.errorf(p.tok.pos, "expected )")
+		}
+
+		return f, p.next()
+	case policyTokenRef:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		name, err := p.parseName("filter name")
+		if err != nil {
+			return nil, err
+		}
+
+		return &Filter{name: name}, nil
+	}
+
+	f := new(Filter)
+
+	key, err := p.parseName("filter key")
+	if err != nil {
+		return nil, err
+	}
+
+	f.key = key
+
+	if p.tok.typ == policyTokenWord {
+		for _, o := range policyOperations {
+			if p.tok.val == o.name {
+				f.op = o.op
+			}
+		}
+	}
+
+	if f.op == UnspecifiedOperation {
+		return nil, p.errorf(p.tok.pos, "expected filter operation")
+	}
+
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	if f.value, err = p.parseName("filter value"); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (p *policyParser) parseCount(name string) (uint32, error) {
+	if p.tok.typ != policyTokenWord {
+		return 0, p.errorf(p.tok.pos, "expected %s", name)
+	}
+
+	v, err := strconv.ParseUint(p.tok.val, 10, 32)
+	if err != nil {
+		return 0, p.errorf(p.tok.pos, "invalid %s %q", name, p.tok.val)
+	}
+
+	return uint32(v), p.next()
+}
+
+func (p *policyParser) parseName(name string) (string, error) {
+	switch p.tok.typ {
+	case policyTokenWord:
+		if _, ok := policyKeywords[p.tok.val]; ok {
+			return "", p.errorf(p.tok.pos, "unexpected keyword %s, expected %s", p.tok.val, name)
+		}
+
+		fallthrough
+	case policyTokenString:
+		val := p.tok.val
+
+		return val, p.next()
+	case policyTokenEOF:
+		return "", p.errorf(p.tok.pos, "unexpected end of policy, expected %s", name)
+	default:
+		return "", p.errorf(p.tok.pos, "expected %s", name)
+	}
+}
+
+func (p *policyParser) isKeyword(keyword string) bool {
+	return p.tok.typ == policyTokenWord && p.tok.val == keyword
+}
+
+func (p *policyParser) errorf(pos int, format string, args ...interface{}) error {
+	line := strings.Count(p.s[:pos], "\n")
+	lineStart := strings.LastIndexByte(p.s[:pos], '\n') + 1
+
+	return &PolicySyntaxError{
+		Line:   line + 1,
+		Column: utf8.RuneCountInString(p.s[lineStart:pos]) + 1,
+		Msg:    fmt.Sprintf(format, args...),
+	}
+}
+
+// next reads the next token of the policy.
+func (p *policyParser) next() error {
+	for p.pos < len(p.s) {
+		r, size := utf8.DecodeRuneInString(p.s[p.pos:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+
+		p.pos += size
+	}
+
+	p.tok = policyToken{pos: p.pos}
+
+	if p.pos == len(p.s) {
+		p.tok.typ = policyTokenEOF
+		return nil
+	}
+
+	switch p.s[p.pos] {
+	case '(':
+		p.tok.typ = policyTokenLParen
+	case ')':
+		p.tok.typ = policyTokenRParen
+	case '*':
+		p.tok.typ = policyTokenAll
+	case '@':
+		p.tok.typ = policyTokenRef
+	case '"':
+		return p.readString()
+	default:
+		return p.readWord()
+	}
+
+	p.pos++
+
+	return nil
+}
+
+func (p *policyParser) readString() error {
+	for i := p.pos + 1; i < len(p.s); i++ {
+		switch p.s[i] {
+		case '\\':
+			i++
+		case '\n':
+			return p.errorf(p.pos, "unterminated string")
+		case '"':
+			val, err := strconv.Unquote(p.s[p.pos : i+1])
+			if err != nil {
+				return p.errorf(p.pos, "invalid string: %v", errors.Cause(err))
+			}
+
+			p.tok.typ = policyTokenString
+			p.tok.val = val
+			p.pos = i + 1
+
+			return nil
+		}
+	}
+
+	return p.errorf(p.pos, "unterminated string")
+}
+
+func (p *policyParser) readWord() error {
+	start := p.pos
+
+	for p.pos < len(p.s) {
+		r, size := utf8.DecodeRuneInString(p.s[p.pos:])
+		if !isPolicyWordRune(r) {
+			break
+		}
+
+		p.pos += size
+	}
+
+	if p.pos == start {
+		r, _ := utf8.DecodeRuneInString(p.s[start:])
+		return p.errorf(start, "unexpected character %q", r)
+	}
+
+	p.tok.typ = policyTokenWord
+	p.tok.val = p.s[start:p.pos]
+
+	return nil
+}
+
+func isPolicyWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_.:$-/+", r)
+}
+
+func formatPolicyName(s string) string {
+	if _, ok := policyKeywords[s]; ok || s == "" {
+		return strconv.Quote(s)
+	}
+
+	for _, r := range s {
+		if !isPolicyWordRune(r) {
+			return strconv.Quote(s)
+		}
+	}
+
+	return s
+}
+
+// FormatPlacementPolicy returns text of the placement policy in the
+// format of ParsePlacementPolicy, one statement per line.
+//
+// Returns an error if the policy can not be represented in the text
+// form, e.g. has no replicas or contains AND/OR filters with less than
+// two operands.
+func FormatPlacementPolicy(p *PlacementPolicy) (string, error) {
+	if len(p.GetReplicas()) == 0 {
+		return "", errors.New("no replicas")
+	}
+
+	b := new(strings.Builder)
+
+	for _, r := range p.GetReplicas() {
+		fmt.Fprintf(b, "%s %d", policyRep, r.GetCount())
+
+		if r.GetSelector() != "" {
+			b.WriteString(" " + policyIn + " " + formatPolicyName(r.GetSelector()))
+		}
+
+		b.WriteByte('\n')
+	}
+
+	if cbf := p.GetContainerBackupFactor(); cbf != 0 {
+		fmt.Fprintf(b, "%s %d\n", policyCBF, cbf)
+	}
+
+	for i, s := range p.GetSelectors() {
+		fmt.Fprintf(b, "%s %d", policySelect, s.GetCount())
+
+		if s.GetClause() != UnspecifiedClause || s.GetAttribute() != "" {
+			b.WriteString(" " + policyIn)
+
+			switch c := s.GetClause(); c {
+			case UnspecifiedClause:
+			case Same:
+				b.WriteString(" " + policySame)
+			case Distinct:
+				b.WriteString(" " + policyDistinct)
+			default:
+				return "", errors.Errorf("unsupported clause %d of selector #%d", c, i)
+			}
+
+			if s.GetAttribute() != "" {
+				b.WriteString(" " + formatPolicyName(s.GetAttribute()))
+			}
+		}
+
+		filter := MainFilterName
+		if s.GetFilter() != MainFilterName {
+			filter = formatPolicyName(s.GetFilter())
+		}
+
+		b.WriteString(" " + policyFrom + " " + filter)
+
+		if s.GetName() != "" {
+			b.WriteString(" " + policyAs + " " + formatPolicyName(s.GetName()))
+		}
+
+		b.WriteByte('\n')
+	}
+
+	for i, f := range p.GetFilters() {
+		if f.GetName() == "" {
+			return "", errors.Errorf("missing name of filter #%d", i)
+		}
+
+		b.WriteString(policyFilter + " ")
+
+		if err := formatFilter(b, f, true); err != nil {
+			return "", errors.Wrapf(err, "filter %s", f.GetName())
+		}
+
+		b.WriteString(" " + policyAs + " " + formatPolicyName(f.GetName()) + "\n")
+	}
+
+	return b.String(), nil
+}
+
+// formatFilter writes filter expression. Top-level filter name is not
+// written, inner filters with name are written as references.
+func formatFilter(b *strings.Builder, f *Filter, top bool) error {
+	switch op := f.GetOp(); op {
+	case UnspecifiedOperation:
+		if top || f.GetName() == "" {
+			return errors.New("missing operation")
+		}
+
+		b.WriteString("@" + formatPolicyName(f.GetName()))
+	case AND, OR:
+		if !top && f.GetName() != "" {
+			return errors.Errorf("inner filter %s with operation", f.GetName())
+		}
+
+		inner := f.GetFilters()
+		if len(inner) < 2 {
+			return errors.Errorf("less than two operands of %s", policyOperationName(op))
+		}
+
+		if f.GetKey() != "" || f.GetValue() != "" {
+			return errors.Errorf("key or value of %s", policyOperationName(op))
+		}
+
+		for i, fi := range inner {
+			if i > 0 {
+				b.WriteString(" " + policyOperationName(op) + " ")
+			}
+
+			compound := fi.GetOp() == AND || fi.GetOp() == OR
+			if compound {
+				b.WriteByte('(')
+			}
+
+			if err := formatFilter(b, fi, false); err != nil {
+				return err
+			}
+
+			if compound {
+				b.WriteByte(')')
+			}
+		}
+	default:
+		name := policyOperationName(op)
+		if name == "" {
+			return errors.Errorf("unsupported operation %d", op)
+		}
+
+		if !top && f.GetName() != "" {
+			return errors.Errorf("inner filter %s with operation", f.GetName())
+		}
+
+		if len(f.GetFilters()) != 0 {
+			return errors.Errorf("inner filters of %s", name)
+		}
+
+		b.WriteString(formatPolicyName(f.GetKey()) + " " + name + " " + formatPolicyName(f.GetValue()))
+	}
+
+	return nil
+}
+
+func policyOperationName(op Operation) string {
+	switch op {
+	case AND:
+		return policyAnd
+	case OR:
+		return policyOr
+	}
+
+	for _, o := range policyOperations {
+		if o.op == op {
+			return o.name
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,143 @@
+package netmap_test
+
+import (
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePlacementPolicy(t *testing.T) {
+	p, err := netmap.ParsePlacementPolicy(`
+REP 1 IN X
+REP 2
+CBF 2
+SELECT 2 IN SAME Country FROM F AS X
+SELECT 1 IN DISTINCT FROM *
+FILTER Country EQ RU AND (Rating GE 4 OR @Trusted) AS F
+FILTER "Trusted node" NE "AND" AS Trusted`)
+	require.NoError(t, err)
+
+	require.Equal(t, newPolicy(2,
+		[]*netmap.Replica{newReplica(1, "X"), newReplica(2, "")},
+		[]*netmap.Selector{
+			newSelector("X", "Country", netmap.Same, 2, "F"),
+			newSelector("", "", netmap.Distinct, 1, netmap.MainFilterName),
+		},
+		[]*netmap.Filter{
+			newFilter("F", "", netmap.AND, "",
+				newFilter("", "Country", netmap.EQ, "RU"),
+				newFilter("", "", netmap.OR, "",
+					newFilter("", "Rating", netmap.GE, "4"),
+					newFilter("Trusted", "", netmap.UnspecifiedOperation, ""),
+				),
+			),
+			newFilter("Trusted", "Trusted node", netmap.NE, "AND"),
+		},
+	), p)
+
+	t.Run("precedence", func(t *testing.T) {
+		p, err := netmap.ParsePlacementPolicy(`REP 1 FILTER a EQ 1 OR b EQ 2 AND c EQ 3 OR (d EQ 4 OR e EQ 5) AS F`)
+		require.NoError(t, err)
+
+		require.Equal(t, []*netmap.Filter{
+			newFilter("F", "", netmap.OR, "",
+				newFilter("", "a", netmap.EQ, "1"),
+				newFilter("", "", netmap.AND, "",
+					newFilter("", "b", netmap.EQ, "2"),
+					newFilter("", "c", netmap.EQ, "3"),
+				),
+				newFilter("", "", netmap.OR, "",
+					newFilter("", "d", netmap.EQ, "4"),
+					newFilter("", "e", netmap.EQ, "5"),
+				),
+			),
+		}, p.GetFilters())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, tc := range []struct {
+			text   string
+			line   int
+			column int
+		}{
+			{text: ``, line: 1, column: 1},
+			{text: `REP`, line: 1, column: 4},
+			{text: `REP x`, line: 1, column: 5},
+			{text: "REP 1\nSELECT 1 IN FROM *", line: 2, column: 10},
+			{text: "REP 1\nSELECT 1 FROM", line: 2, column: 14},
+			{text: "REP 1\nFILTER a EQ b", line: 2, column: 14},
+			{text: "REP 1\nFILTER a IS b AS F", line: 2, column: 10},
+			{text: "REP 1\nFILTER (a EQ b AS F", line: 2, column: 16},
+			{text: "REP 1\nFILTER @G AS F", line: 2, column: 8},
+			{text: "REP 1\n\tFILTER ключ EQ \"b AS F", line: 2, column: 17},
+			{text: "REP 1 IN X #", line: 1, column: 12},
+			{text: "REP 1 CBF 1 REP 1", line: 1, column: 13},
+		} {
+			_, err := netmap.ParsePlacementPolicy(tc.text)
+
+			e, ok := err.(*netmap.PolicySyntaxError)
+			require.True(t, ok, tc.text)
+			require.Equal(t, tc.line, e.Line, tc.text)
+			require.Equal(t, tc.column, e.Column, tc.text)
+		}
+	})
+}
+
+func TestFormatPlacementPolicy(t *testing.T) {
+	p := newPolicy(3,
+		[]*netmap.Replica{newReplica(1, "X"), newReplica(2, "my selector")},
+		[]*netmap.Selector{
+			newSelector("X", "Country", netmap.Same, 2, "F"),
+			newSelector("my selector", "City", netmap.UnspecifiedClause, 1, netmap.MainFilterName),
+			newSelector("", "", netmap.Distinct, 3, "F"),
+		},
+		[]*netmap.Filter{
+			newFilter("F", "", netmap.OR, "",
+				newFilter("", "", netmap.OR, "",
+					newFilter("", "Rating", netmap.LT, "4"),
+					newFilter("G", "", netmap.UnspecifiedOperation, ""),
+				),
+				newFilter("", "", netmap.AND, "",
+					newFilter("", "Rating", netmap.GT, "4"),
+					newFilter("", "Key", netmap.LE, ""),
+				),
+			),
+			newFilter("G", "Country", netmap.EQ, "SELECT"),
+		},
+	)
+
+	text, err := netmap.FormatPlacementPolicy(p)
+	require.NoError(t, err)
+	require.Equal(t, `REP 1 IN X
+REP 2 IN "my selector"
+CBF 3
+SELECT 2 IN SAME Country FROM F AS X
+SELECT 1 IN City FROM * AS "my selector"
+SELECT 3 IN DISTINCT FROM F
+FILTER (Rating LT 4 OR @G) OR (Rating GT 4 AND Key LE "") AS F
+FILTER Country EQ "SELECT" AS G
+`, text)
+
+	parsed, err := netmap.ParsePlacementPolicy(text)
+	require.NoError(t, err)
+	require.Equal(t, p, parsed)
+
+	t.Run("unsupported", func(t *testing.T) {
+		for _, p := range []*netmap.PlacementPolicy{
+			newPolicy(0, nil, nil, nil),
+			newPolicy(0, []*netmap.Replica{newReplica(1, "")}, nil, []*netmap.Filter{
+				newFilter("", "a", netmap.EQ, "b"),
+			}),
+			newPolicy(0, []*netmap.Replica{newReplica(1, "")}, nil, []*netmap.Filter{
+				newFilter("F", "", netmap.AND, "", newFilter("", "a", netmap.EQ, "b")),
+			}),
+			newPolicy(0, []*netmap.Replica{newReplica(1, "")}, nil, []*netmap.Filter{
+				newFilter("F", "", netmap.UnspecifiedOperation, ""),
+			}),
+		} {
+			_, err := netmap.FormatPlacementPolicy(p)
+			require.Error(t, err)
+		}
+	})
+}
@@ -0,0 +1,44 @@
+package netmap
+
+import (
+	"context"
+
+	netmap "github.com/cthulhu-rider/neofs-api-go/v2/netmap/grpc"
+	"google.golang.org/grpc"
+)
+
+type grpcServer struct {
+	svc Service
+}
+
+// NewGRPCServer returns gRPC server of the netmap service
+// which handles requests with svc.
+func NewGRPCServer(svc Service) netmap.NetmapServiceServer {
+	return &grpcServer{
+		svc: svc,
+	}
+}
+
+// RegisterGRPCServer registers gRPC server of the netmap service
+// which handles requests with svc on gs.
+func RegisterGRPCServer(gs *grpc.Server, svc Service) {
+	netmap.RegisterNetmapServiceServer(gs, NewGRPCServer(svc))
+}
+
+func (s *grpcServer) LocalNodeInfo(ctx context.Context, req *netmap.LocalNodeInfoRequest) (*netmap.LocalNodeInfoResponse, error) {
+	resp, err := s.svc.LocalNodeInfo(ctx, LocalNodeInfoRequestFromGRPCMessage(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return LocalNodeInfoResponseToGRPCMessage(resp), nil
+}
+
+func (s *grpcServer) NetworkInfo(ctx context.Context, req *netmap.NetworkInfoRequest) (*netmap.NetworkInfoResponse, error) {
+	resp, err := s.svc.NetworkInfo(ctx, NetworkInfoRequestFromGRPCMessage(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return NetworkInfoResponseToGRPCMessage(resp), nil
+}
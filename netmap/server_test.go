@@ -0,0 +1,86 @@
+package netmap_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/client"
+	"github.com/cthulhu-rider/neofs-api-go/v2/internal/grpctest"
+	"github.com/cthulhu-rider/neofs-api-go/v2/netmap"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testService records request bodies and responds with test fixtures.
+type testService struct {
+	err error
+
+	body interface{}
+}
+
+func (s *testService) LocalNodeInfo(_ context.Context, req *netmap.LocalNodeInfoRequest) (*netmap.LocalNodeInfoResponse, error) {
+	s.body = req.GetBody()
+
+	resp := new(netmap.LocalNodeInfoResponse)
+	resp.SetBody(generateNodeInfoResponseBody())
+
+	return resp, s.err
+}
+
+func (s *testService) NetworkInfo(_ context.Context, req *netmap.NetworkInfoRequest) (*netmap.NetworkInfoResponse, error) {
+	s.body = req.GetBody()
+
+	resp := new(netmap.NetworkInfoResponse)
+	resp.SetBody(generateNetworkInfoResponseBody())
+
+	return resp, s.err
+}
+
+func TestGRPCServer(t *testing.T) {
+	svc := new(testService)
+
+	conn := grpctest.Dial(t, func(gs *grpc.Server) {
+		netmap.RegisterGRPCServer(gs, svc)
+	})
+
+	c, err := netmap.NewClient(netmap.WithGlobalOpts(client.WithGRPCConn(conn)))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("local node info", func(t *testing.T) {
+		req := new(netmap.LocalNodeInfoRequest)
+		req.SetBody(new(netmap.LocalNodeInfoRequestBody))
+
+		resp, err := c.LocalNodeInfo(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, req.GetBody(), svc.body)
+		require.Equal(t, generateNodeInfoResponseBody(), resp.GetBody())
+	})
+
+	t.Run("network info", func(t *testing.T) {
+		req := new(netmap.NetworkInfoRequest)
+		req.SetBody(new(netmap.NetworkInfoRequestBody))
+
+		resp, err := c.NetworkInfo(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, req.GetBody(), svc.body)
+		require.Equal(t, generateNetworkInfoResponseBody(), resp.GetBody())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		svc.err = status.Error(codes.Unavailable, "node is offline")
+		defer func() { svc.err = nil }()
+
+		_, err := c.LocalNodeInfo(ctx, new(netmap.LocalNodeInfoRequest))
+		require.Error(t, err)
+
+		st, ok := status.FromError(errors.Cause(err))
+		require.True(t, ok)
+		require.Equal(t, codes.Unavailable, st.Code())
+		require.Equal(t, "node is offline", st.Message())
+	})
+}
@@ -0,0 +1,318 @@
+package netmap
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PolicyError describes a problem of the placement policy element.
+type PolicyError struct {
+	// Path is a path to the element in the policy,
+	// e.g. filters[1].filters[0].value.
+	Path string
+
+	// Msg describes the problem.
+	Msg string
+}
+
+func (e *PolicyError) Error() string {
+	return e.Path + ": " + e.Msg
+}
+
+// PolicyValidationError contains all problems of the placement policy
+// found by ValidatePlacementPolicy.
+type PolicyValidationError struct {
+	// Errors is a list of problems in the order of policy elements.
+	Errors []*PolicyError
+}
+
+func (e *PolicyValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+
+	for _, pe := range e.Errors {
+		msgs = append(msgs, pe.Error())
+	}
+
+	return "invalid placement policy: " + strings.Join(msgs, "; ")
+}
+
+type policyValidator struct {
+	policy *PlacementPolicy
+
+	filters map[string]int
+
+	errs []*PolicyError
+}
+
+// ValidatePlacementPolicy statically checks that placement policy is well
+// formed:
+//   - policy has at least one replica;
+//   - replica, selector and node counts are not zero;
+//   - selectors and filters referenced by name exist, names are unique;
+//   - top-level filters are named and do not use reserved MainFilterName;
+//   - filter references have no cycles;
+//   - AND and OR filters have inner filters and have no key and value;
+//   - simple filters have a key and no inner filters, values of GT, GE,
+//     LT and LE filters are unsigned integers;
+//   - inner filters with name are references with no other fields set.
+//
+// Returns *PolicyValidationError with all found problems.
+func ValidatePlacementPolicy(p *PlacementPolicy) error {
+	if p == nil {
+		return &PolicyValidationError{
+			Errors: []*PolicyError{{Path: "policy", Msg: "missing policy"}},
+		}
+	}
+
+	v := &policyValidator{
+		policy:  p,
+		filters: make(map[string]int),
+	}
+
+	v.validateFilterNames()
+	v.validateReplicas()
+	v.validateSelectors()
+	v.validateFilters()
+
+	if len(v.errs) > 0 {
+		return &PolicyValidationError{
+			Errors: v.errs,
+		}
+	}
+
+	return nil
+}
+
+func (v *policyValidator) errorf(path string, format string, args ...interface{}) {
+	v.errs = append(v.errs, &PolicyError{
+		Path: path,
+		Msg:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *policyValidator) validateFilterNames() {
+	for i, f := range v.policy.GetFilters() {
+		if name := f.GetName(); name != "" && name != MainFilterName {
+			if _, ok := v.filters[name]; !ok {
+				v.filters[name] = i
+			}
+		}
+	}
+}
+
+func (v *policyValidator) validateReplicas() {
+	if len(v.policy.GetReplicas()) == 0 {
+		v.errorf("replicas", "no replicas")
+	}
+
+	selectors := make(map[string]struct{}, len(v.policy.GetSelectors()))
+	for _, s := range v.policy.GetSelectors() {
+		selectors[s.GetName()] = struct{}{}
+	}
+
+	for i, r := range v.policy.GetReplicas() {
+		path := fmt.Sprintf("replicas[%d]", i)
+
+		if r.GetCount() == 0 {
+			v.errorf(path+".count", "zero count")
+		}
+
+		if name := r.GetSelector(); name != "" {
+			if _, ok := selectors[name]; !ok {
+				v.errorf(path+".selector", "unknown selector %s", name)
+			}
+		}
+	}
+}
+
+func (v *policyValidator) validateSelectors() {
+	names := make(map[string]struct{}, len(v.policy.GetSelectors()))
+
+	for i, s := range v.policy.GetSelectors() {
+		path := fmt.Sprintf("selectors[%d]", i)
+
+		if name := s.GetName(); name != "" {
+			if _, ok := names[name]; ok {
+				v.errorf(path+".name", "duplicate selector %s", name)
+			}
+
+			names[name] = struct{}{}
+		}
+
+		if s.GetCount() == 0 {
+			v.errorf(path+".count", "zero count")
+		}
+
+		if c := s.GetClause(); c > Distinct {
+			v.errorf(path+".clause", "unsupported clause %d", c)
+		}
+
+		switch name := s.GetFilter(); name {
+		case "":
+			v.errorf(path+".filter", "missing filter")
+		case MainFilterName:
+		default:
+			if _, ok := v.filters[name]; !ok {
+				v.errorf(path+".filter", "unknown filter %s", name)
+			}
+		}
+	}
+}
+
+func (v *policyValidator) validateFilters() {
+	names := make(map[string]struct{}, len(v.policy.GetFilters()))
+
+	for i, f := range v.policy.GetFilters() {
+		path := fmt.Sprintf("filters[%d]", i)
+
+		switch name := f.GetName(); name {
+		case "":
+			v.errorf(path+".name", "missing name")
+		case MainFilterName:
+			v.errorf(path+".name", "reserved name %s", name)
+		default:
+			if _, ok := names[name]; ok {
+				v.errorf(path+".name", "duplicate filter %s", name)
+			}
+
+			names[name] = struct{}{}
+		}
+
+		if f.GetOp() == UnspecifiedOperation {
+			v.errorf(path+".op", "missing operation")
+			continue
+		}
+
+		v.validateFilter(path, f)
+	}
+
+	v.validateCycles()
+}
+
+// validateFilter checks filter with operation and its inner filters.
+func (v *policyValidator) validateFilter(path string, f *Filter) {
+	switch op := f.GetOp(); op {
+	case AND, OR:
+		if f.GetKey() != "" {
+			v.errorf(path+".key", "key of %s filter", policyOperationName(op))
+		}
+
+		if f.GetValue() != "" {
+			v.errorf(path+".value", "value of %s filter", policyOperationName(op))
+		}
+
+		if len(f.GetFilters()) == 0 {
+			v.errorf(path+".filters", "no inner filters of %s filter", policyOperationName(op))
+		}
+
+		for i, fi := range f.GetFilters() {
+			v.validateInnerFilter(fmt.Sprintf("%s.filters[%d]", path, i), fi)
+		}
+	case EQ, NE, GT, GE, LT, LE:
+		if f.GetKey() == "" {
+			v.errorf(path+".key", "missing key")
+		}
+
+		if len(f.GetFilters()) != 0 {
+			v.errorf(path+".filters", "inner filters of %s filter", policyOperationName(op))
+		}
+
+		if op != EQ && op != NE {
+			if _, err := strconv.ParseUint(f.GetValue(), 10, 64); err != nil {
+				v.errorf(path+".value", "invalid numeric value %q", f.GetValue())
+			}
+		}
+	default:
+		v.errorf(path+".op", "unsupported operation %d", op)
+	}
+}
+
+func (v *policyValidator) validateInnerFilter(path string, f *Filter) {
+	name := f.GetName()
+	if name == "" {
+		if f.GetOp() == UnspecifiedOperation {
+			v.errorf(path+".op", "missing operation")
+			return
+		}
+
+		v.validateFilter(path, f)
+
+		return
+	}
+
+	// named inner filter is a reference to the top-level one
+	if _, ok := v.filters[name]; !ok {
+		v.errorf(path+".name", "unknown filter %s", name)
+	}
+
+	if f.GetOp() != UnspecifiedOperation || f.GetKey() != "" || f.GetValue() != "" || len(f.GetFilters()) != 0 {
+		v.errorf(path, "filter reference %s with other fields set", name)
+	}
+}
+
+// validateCycles reports the references closing the cycles
+// of top-level filters.
+func (v *policyValidator) validateCycles() {
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+
+	fs := v.policy.GetFilters()
+	state := make([]int, len(fs))
+
+	var (
+		stack []string
+		visit func(i int)
+	)
+
+	visit = func(i int) {
+		state[i] = inProgress
+		stack = append(stack, fs[i].GetName())
+
+		forEachReference(fmt.Sprintf("filters[%d]", i), fs[i], func(path, name string) {
+			j, ok := v.filters[name]
+			if !ok {
+				return
+			}
+
+			switch state[j] {
+			case unvisited:
+				visit(j)
+			case inProgress:
+				k := len(stack) - 1
+				for stack[k] != name {
+					k--
+				}
+
+				v.errorf(path, "cyclic reference %s", strings.Join(append(stack[k:], name), " -> "))
+			}
+		})
+
+		stack = stack[:len(stack)-1]
+		state[i] = done
+	}
+
+	for i := range fs {
+		if state[i] == unvisited && v.filters[fs[i].GetName()] == i {
+			visit(i)
+		}
+	}
+}
+
+// forEachReference calls f with path and name of each filter reference
+// in the inner filters.
+func forEachReference(path string, f *Filter, fn func(path, name string)) {
+	for i, fi := range f.GetFilters() {
+		p := fmt.Sprintf("%s.filters[%d]", path, i)
+
+		if name := fi.GetName(); name != "" {
+			fn(p, name)
+			continue
+		}
+
+		forEachReference(p, fi, fn)
+	}
+}
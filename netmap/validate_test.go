@@ -0,0 +1,132 @@
+package netmap_test
+
+import (
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePlacementPolicy(t *testing.T) {
+	p, err := netmap.ParsePlacementPolicy(`
+REP 1 IN X
+CBF 2
+SELECT 2 IN SAME Country FROM F AS X
+FILTER Country EQ RU AND (Rating GE 4 OR @G) AS F
+FILTER Trusted EQ true AS G`)
+	require.NoError(t, err)
+	require.NoError(t, netmap.ValidatePlacementPolicy(p))
+
+	for _, tc := range []struct {
+		name   string
+		policy *netmap.PlacementPolicy
+		paths  []string
+	}{
+		{
+			name:   "nil",
+			policy: nil,
+			paths:  []string{"policy"},
+		},
+		{
+			name:   "no replicas",
+			policy: newPolicy(0, nil, nil, nil),
+			paths:  []string{"replicas"},
+		},
+		{
+			name: "missing references",
+			policy: newPolicy(0,
+				[]*netmap.Replica{newReplica(1, "Y")},
+				[]*netmap.Selector{newSelector("X", "", netmap.Distinct, 1, "G")},
+				[]*netmap.Filter{
+					newFilter("F", "", netmap.AND, "",
+						newFilter("H", "", netmap.UnspecifiedOperation, ""),
+						newFilter("", "a", netmap.EQ, "b"),
+					),
+				},
+			),
+			paths: []string{"replicas[0].selector", "selectors[0].filter", "filters[0].filters[0].name"},
+		},
+		{
+			name: "zero counts and duplicates",
+			policy: newPolicy(0,
+				[]*netmap.Replica{newReplica(0, "X")},
+				[]*netmap.Selector{
+					newSelector("X", "", netmap.Distinct, 0, netmap.MainFilterName),
+					newSelector("X", "", netmap.Distinct, 1, netmap.MainFilterName),
+				},
+				[]*netmap.Filter{
+					newFilter("F", "a", netmap.EQ, "b"),
+					newFilter("F", "a", netmap.NE, "b"),
+					newFilter(netmap.MainFilterName, "a", netmap.NE, "b"),
+				},
+			),
+			paths: []string{
+				"replicas[0].count",
+				"selectors[0].count",
+				"selectors[1].name",
+				"filters[1].name",
+				"filters[2].name",
+			},
+		},
+		{
+			name: "cycle",
+			policy: newPolicy(0,
+				[]*netmap.Replica{newReplica(1, "")},
+				nil,
+				[]*netmap.Filter{
+					newFilter("A", "", netmap.AND, "",
+						newFilter("", "", netmap.OR, "",
+							newFilter("B", "", netmap.UnspecifiedOperation, ""),
+							newFilter("", "a", netmap.EQ, "b"),
+						),
+					),
+					newFilter("B", "", netmap.OR, "",
+						newFilter("A", "", netmap.UnspecifiedOperation, ""),
+						newFilter("B", "", netmap.UnspecifiedOperation, ""),
+					),
+				},
+			),
+			paths: []string{"filters[1].filters[0]", "filters[1].filters[1]"},
+		},
+		{
+			name: "malformed filters",
+			policy: newPolicy(0,
+				[]*netmap.Replica{newReplica(1, "")},
+				nil,
+				[]*netmap.Filter{
+					newFilter("A", "key", netmap.AND, "value"),
+					newFilter("B", "a", netmap.EQ, "b", newFilter("", "c", netmap.EQ, "d")),
+					newFilter("C", "", netmap.GT, "x"),
+					newFilter("D", "", netmap.UnspecifiedOperation, ""),
+					newFilter("E", "", netmap.OR, "",
+						newFilter("", "", netmap.UnspecifiedOperation, ""),
+						newFilter("A", "a", netmap.EQ, "b"),
+					),
+				},
+			),
+			paths: []string{
+				"filters[0].key",
+				"filters[0].value",
+				"filters[0].filters",
+				"filters[1].filters",
+				"filters[2].key",
+				"filters[2].value",
+				"filters[3].op",
+				"filters[4].filters[0].op",
+				"filters[4].filters[1]",
+			},
+		},
+	} {
+		err := netmap.ValidatePlacementPolicy(tc.policy)
+
+		e, ok := err.(*netmap.PolicyValidationError)
+		require.True(t, ok, tc.name)
+
+		paths := make([]string, 0, len(e.Errors))
+		for _, pe := range e.Errors {
+			paths = append(paths, pe.Path)
+		}
+
+		require.Equal(t, tc.paths, paths, tc.name)
+	}
+}
@@ -1,5 +1,13 @@
 package object
 
+import (
+	"strconv"
+	"time"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/internal/attribute"
+	"github.com/pkg/errors"
+)
+
 // SysAttributePrefix is a prefix of key to system attribute.
 const SysAttributePrefix = "__NEOFS__"
 
@@ -10,3 +18,178 @@ const (
 	// SysAttributeExpEpoch tells GC to delete object after that epoch.
 	SysAttributeExpEpoch = SysAttributePrefix + "EXPIRATION_EPOCH"
 )
+
+const (
+	// AttributeFileName is a key to the name of the file stored in the object.
+	AttributeFileName = "FileName"
+
+	// AttributeFilePath is a key to the path of the file stored in the object.
+	AttributeFilePath = "FilePath"
+
+	// AttributeTimestamp is a key to the user-defined object creation time
+	// in Unix seconds.
+	AttributeTimestamp = "Timestamp"
+
+	// AttributeContentType is a key to the MIME type of the object payload.
+	AttributeContentType = "Content-Type"
+)
+
+// ErrDuplicateAttribute is returned when header contains several
+// attributes with the same key.
+var ErrDuplicateAttribute = attribute.ErrDuplicate
+
+// attribute returns the value of the attribute with the key.
+func (h *Header) attribute(key string) (string, bool, error) {
+	as := h.GetAttributes()
+
+	i, err := attribute.Find(len(as), func(i int) string { return as[i].GetKey() }, key)
+	if err != nil || i < 0 {
+		return "", false, err
+	}
+
+	return as[i].GetValue(), true, nil
+}
+
+// setAttribute sets the value of the attribute with the key, or adds
+// new attribute if there is no such key.
+func (h *Header) setAttribute(key, val string) error {
+	if h == nil {
+		return nil
+	}
+
+	i, err := attribute.Find(len(h.attr), func(i int) string { return h.attr[i].GetKey() }, key)
+	if err != nil {
+		return err
+	}
+
+	if i < 0 {
+		a := new(Attribute)
+		a.SetKey(key)
+
+		i = len(h.attr)
+		h.attr = append(h.attr, a)
+	}
+
+	h.attr[i].SetValue(val)
+
+	return nil
+}
+
+// GetFileName returns the value of AttributeFileName attribute.
+//
+// Returns empty string if the attribute is not set and an error wrapping
+// ErrDuplicateAttribute if it is set several times.
+func (h *Header) GetFileName() (string, error) {
+	v, _, err := h.attribute(AttributeFileName)
+	return v, err
+}
+
+// SetFileName sets the value of AttributeFileName attribute.
+//
+// Returns an error wrapping ErrDuplicateAttribute if the attribute
+// is set several times.
+func (h *Header) SetFileName(v string) error {
+	return h.setAttribute(AttributeFileName, v)
+}
+
+// GetFilePath returns the value of AttributeFilePath attribute.
+//
+// Returns empty string if the attribute is not set and an error wrapping
+// ErrDuplicateAttribute if it is set several times.
+func (h *Header) GetFilePath() (string, error) {
+	v, _, err := h.attribute(AttributeFilePath)
+	return v, err
+}
+
+// SetFilePath sets the value of AttributeFilePath attribute.
+//
+// Returns an error wrapping ErrDuplicateAttribute if the attribute
+// is set several times.
+func (h *Header) SetFilePath(v string) error {
+	return h.setAttribute(AttributeFilePath, v)
+}
+
+// GetContentType returns the value of AttributeContentType attribute.
+//
+// Returns empty string if the attribute is not set and an error wrapping
+// ErrDuplicateAttribute if it is set several times.
+func (h *Header) GetContentType() (string, error) {
+	v, _, err := h.attribute(AttributeContentType)
+	return v, err
+}
+
+// SetContentType sets the value of AttributeContentType attribute.
+//
+// Returns an error wrapping ErrDuplicateAttribute if the attribute
+// is set several times.
+func (h *Header) SetContentType(v string) error {
+	return h.setAttribute(AttributeContentType, v)
+}
+
+// GetTimestamp returns the time from AttributeTimestamp attribute.
+//
+// Returns zero time if the attribute is not set, an error wrapping
+// ErrDuplicateAttribute if it is set several times and an error
+// wrapping ErrInvalidAttribute if its value is not a number of
+// Unix seconds.
+func (h *Header) GetTimestamp() (time.Time, error) {
+	v, ok, err := h.attribute(AttributeTimestamp)
+	if err != nil || !ok {
+		return time.Time{}, err
+	}
+
+	return attribute.ParseTimestamp(AttributeTimestamp, v)
+}
+
+// SetTimestamp sets AttributeTimestamp attribute to the time in
+// Unix seconds.
+//
+// Returns an error wrapping ErrDuplicateAttribute if the attribute
+// is set several times.
+func (h *Header) SetTimestamp(v time.Time) error {
+	return h.setAttribute(AttributeTimestamp, attribute.FormatTimestamp(v))
+}
+
+// GetExpirationEpoch returns the epoch from SysAttributeExpEpoch attribute.
+//
+// Returns 0 if the attribute is not set, an error wrapping
+// ErrDuplicateAttribute if it is set several times and an error
+// wrapping ErrInvalidAttribute if its value is not a decimal number.
+func (h *Header) GetExpirationEpoch() (uint64, error) {
+	v, ok, err := h.attribute(SysAttributeExpEpoch)
+	if err != nil || !ok {
+		return 0, err
+	}
+
+	epoch, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(ErrInvalidAttribute, "%s: invalid epoch %q", SysAttributeExpEpoch, v)
+	}
+
+	return epoch, nil
+}
+
+// SetExpirationEpoch sets SysAttributeExpEpoch attribute to the epoch.
+//
+// Returns an error wrapping ErrDuplicateAttribute if the attribute
+// is set several times.
+func (h *Header) SetExpirationEpoch(v uint64) error {
+	return h.setAttribute(SysAttributeExpEpoch, strconv.FormatUint(v, 10))
+}
+
+// GetUploadID returns the value of SysAttributeUploadID attribute.
+//
+// Returns empty string if the attribute is not set and an error wrapping
+// ErrDuplicateAttribute if it is set several times.
+func (h *Header) GetUploadID() (string, error) {
+	v, _, err := h.attribute(SysAttributeUploadID)
+	return v, err
+}
+
+// SetUploadID sets the value of SysAttributeUploadID attribute.
+//
+// Returns an error wrapping ErrDuplicateAttribute if the attribute
+// is set several times.
+func (h *Header) SetUploadID(v string) error {
+	return h.setAttribute(SysAttributeUploadID, v)
+}
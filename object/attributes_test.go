@@ -0,0 +1,107 @@
+package object_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeader_WellKnownAttributes(t *testing.T) {
+	hdr := new(object.Header)
+
+	name, err := hdr.GetFileName()
+	require.NoError(t, err)
+	require.Empty(t, name)
+
+	ts, err := hdr.GetTimestamp()
+	require.NoError(t, err)
+	require.True(t, ts.IsZero())
+
+	exp, err := hdr.GetExpirationEpoch()
+	require.NoError(t, err)
+	require.Zero(t, exp)
+
+	now := time.Unix(time.Now().Unix(), 0)
+
+	require.NoError(t, hdr.SetFileName("cat.jpg"))
+	require.NoError(t, hdr.SetFilePath("/pics/cat.jpg"))
+	require.NoError(t, hdr.SetContentType("image/jpeg"))
+	require.NoError(t, hdr.SetTimestamp(now))
+	require.NoError(t, hdr.SetExpirationEpoch(13))
+	require.NoError(t, hdr.SetUploadID("upload"))
+
+	// repeated setter overwrites the value
+	require.NoError(t, hdr.SetFileName("dog.jpg"))
+	require.Len(t, hdr.GetAttributes(), 6)
+
+	name, err = hdr.GetFileName()
+	require.NoError(t, err)
+	require.Equal(t, "dog.jpg", name)
+
+	path, err := hdr.GetFilePath()
+	require.NoError(t, err)
+	require.Equal(t, "/pics/cat.jpg", path)
+
+	typ, err := hdr.GetContentType()
+	require.NoError(t, err)
+	require.Equal(t, "image/jpeg", typ)
+
+	ts, err = hdr.GetTimestamp()
+	require.NoError(t, err)
+	require.True(t, now.Equal(ts))
+
+	exp, err = hdr.GetExpirationEpoch()
+	require.NoError(t, err)
+	require.EqualValues(t, 13, exp)
+
+	id, err := hdr.GetUploadID()
+	require.NoError(t, err)
+	require.Equal(t, "upload", id)
+
+	valid := validHeader()
+	valid.SetAttributes(hdr.GetAttributes())
+	require.NoError(t, object.ValidateHeader(valid))
+}
+
+func TestHeader_WellKnownAttributesErrors(t *testing.T) {
+	newAttr := func(key, val string) *object.Attribute {
+		a := new(object.Attribute)
+		a.SetKey(key)
+		a.SetValue(val)
+
+		return a
+	}
+
+	t.Run("duplicate key", func(t *testing.T) {
+		hdr := new(object.Header)
+		hdr.SetAttributes([]*object.Attribute{
+			newAttr(object.AttributeFileName, "a"),
+			newAttr(object.AttributeFileName, "b"),
+		})
+
+		_, err := hdr.GetFileName()
+		require.True(t, errors.Is(err, object.ErrDuplicateAttribute), err)
+
+		require.True(t, errors.Is(hdr.SetFileName("c"), object.ErrDuplicateAttribute))
+	})
+
+	t.Run("invalid timestamp", func(t *testing.T) {
+		hdr := new(object.Header)
+		hdr.SetAttributes([]*object.Attribute{newAttr(object.AttributeTimestamp, "yesterday")})
+
+		_, err := hdr.GetTimestamp()
+		require.True(t, errors.Is(err, object.ErrInvalidAttribute), err)
+		require.Contains(t, err.Error(), "yesterday")
+	})
+
+	t.Run("invalid expiration", func(t *testing.T) {
+		hdr := new(object.Header)
+		hdr.SetAttributes([]*object.Attribute{newAttr(object.SysAttributeExpEpoch, "-1")})
+
+		_, err := hdr.GetExpirationEpoch()
+		require.True(t, errors.Is(err, object.ErrInvalidAttribute), err)
+	})
+}
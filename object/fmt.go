@@ -0,0 +1,229 @@
+package object
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/signature"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/tz"
+	"github.com/pkg/errors"
+)
+
+// ErrIncorrectID is returned when object identifier does not
+// match the hash of the object header.
+var ErrIncorrectID = errors.New("incorrect object identifier")
+
+// ErrIncorrectPayloadLength is returned when object payload size
+// differs from the length declared in the object header.
+var ErrIncorrectPayloadLength = errors.New("incorrect payload length")
+
+// ErrIncorrectPayloadChecksum is returned when object payload checksum
+// differs from the one declared in the object header.
+var ErrIncorrectPayloadChecksum = errors.New("incorrect payload checksum")
+
+// ErrUnsupportedChecksum is returned when checksum of unsupported
+// type is met.
+var ErrUnsupportedChecksum = errors.New("unsupported checksum type")
+
+// CalculatePayloadChecksum calculates SHA256 checksum of the object payload.
+func CalculatePayloadChecksum(payload []byte) *refs.Checksum {
+	sum := sha256.Sum256(payload)
+
+	cs := new(refs.Checksum)
+	cs.SetType(refs.SHA256)
+	cs.SetSum(sum[:])
+
+	return cs
+}
+
+// CalculateHomomorphicChecksum calculates Tillich-Zemor checksum of the
+// object payload.
+func CalculateHomomorphicChecksum(payload []byte) *refs.Checksum {
+	sum := tz.Sum(payload)
+
+	cs := new(refs.Checksum)
+	cs.SetType(refs.TillichZemor)
+	cs.SetSum(sum[:])
+
+	return cs
+}
+
+// CalculateAndSetPayloadChecksum calculates SHA256 and Tillich-Zemor checksums
+// of the object payload and writes them to the object header together with
+// payload length.
+func CalculateAndSetPayloadChecksum(obj *Object) {
+	hdr := obj.GetHeader()
+	if hdr == nil {
+		hdr = new(Header)
+		obj.SetHeader(hdr)
+	}
+
+	hdr.SetPayloadLength(uint64(len(obj.GetPayload())))
+	hdr.SetPayloadHash(CalculatePayloadChecksum(obj.GetPayload()))
+	hdr.SetHomomorphicHash(CalculateHomomorphicChecksum(obj.GetPayload()))
+}
+
+// CalculateID calculates identifier of the object with the
+// provided header.
+//
+// Identifier is a SHA256 hash of stable marshaled header.
+func CalculateID(hdr *Header) (*refs.ObjectID, error) {
+	data, err := hdr.StableMarshal(nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal object header")
+	}
+
+	sum := sha256.Sum256(data)
+
+	id := new(refs.ObjectID)
+	id.SetValue(sum[:])
+
+	return id, nil
+}
+
+// CalculateAndSetID calculates identifier of the object and writes it
+// to the object.
+func CalculateAndSetID(obj *Object) error {
+	id, err := CalculateID(obj.GetHeader())
+	if err != nil {
+		return err
+	}
+
+	obj.SetObjectID(id)
+
+	return nil
+}
+
+// CalculateIDSignature signs the object identifier with the private key.
+func CalculateIDSignature(key *ecdsa.PrivateKey, id *refs.ObjectID, opts ...signature.SignOption) (*refs.Signature, error) {
+	sig := new(refs.Signature)
+
+	if err := signature.SignDataWithHandler(
+		key,
+		signature.StableMarshalerWrapper{SM: id},
+		func(key, sign []byte) {
+			sig.SetKey(key)
+			sig.SetSign(sign)
+		},
+		opts...,
+	); err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+// CalculateAndSetSignature signs the object identifier with the private key
+// and writes the signature to the object.
+func CalculateAndSetSignature(key *ecdsa.PrivateKey, obj *Object, opts ...signature.SignOption) error {
+	sig, err := CalculateIDSignature(key, obj.GetObjectID(), opts...)
+	if err != nil {
+		return err
+	}
+
+	obj.SetSignature(sig)
+
+	return nil
+}
+
+// SetVerificationFields calculates payload checksum, identifier and
+// identifier signature of the object and writes them to the object.
+func SetVerificationFields(key *ecdsa.PrivateKey, obj *Object, opts ...signature.SignOption) error {
+	CalculateAndSetPayloadChecksum(obj)
+
+	if err := CalculateAndSetID(obj); err != nil {
+		return errors.Wrap(err, "could not set identifier")
+	}
+
+	if err := CalculateAndSetSignature(key, obj, opts...); err != nil {
+		return errors.Wrap(err, "could not set signature")
+	}
+
+	return nil
+}
+
+// VerifyID checks that identifier of the object matches its header.
+func VerifyID(obj *Object) error {
+	id, err := CalculateID(obj.GetHeader())
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(id.GetValue(), obj.GetObjectID().GetValue()) {
+		return ErrIncorrectID
+	}
+
+	return nil
+}
+
+// VerifyIDSignature checks that the object signature is a valid
+// signature of the object identifier.
+func VerifyIDSignature(obj *Object, opts ...signature.SignOption) error {
+	return signature.VerifyDataWithSource(
+		signature.StableMarshalerWrapper{SM: obj.GetObjectID()},
+		func() ([]byte, []byte) {
+			sig := obj.GetSignature()
+			return sig.GetKey(), sig.GetSign()
+		},
+		opts...,
+	)
+}
+
+// VerifyPayloadChecksum checks that length and checksums of the object
+// payload match the ones declared in the object header.
+//
+// Homomorphic checksum is checked only if it is set in the header.
+func VerifyPayloadChecksum(obj *Object) error {
+	hdr := obj.GetHeader()
+	payload := obj.GetPayload()
+
+	if hdr.GetPayloadLength() != uint64(len(payload)) {
+		return ErrIncorrectPayloadLength
+	}
+
+	if err := verifyChecksum(hdr.GetPayloadHash(), payload); err != nil {
+		return err
+	}
+
+	if cs := hdr.GetHomomorphicHash(); cs != nil {
+		return verifyChecksum(cs, payload)
+	}
+
+	return nil
+}
+
+func verifyChecksum(cs *refs.Checksum, payload []byte) error {
+	var exp *refs.Checksum
+
+	switch cs.GetType() {
+	case refs.SHA256:
+		exp = CalculatePayloadChecksum(payload)
+	case refs.TillichZemor:
+		exp = CalculateHomomorphicChecksum(payload)
+	default:
+		return errors.Wrapf(ErrUnsupportedChecksum, "%d", cs.GetType())
+	}
+
+	if !bytes.Equal(exp.GetSum(), cs.GetSum()) {
+		return ErrIncorrectPayloadChecksum
+	}
+
+	return nil
+}
+
+// Verify checks the integrity of the object: identifier must match
+// the header, signature must be valid for the identifier, payload length
+// and checksum must match the ones declared in the header.
+func Verify(obj *Object, opts ...signature.SignOption) error {
+	if err := VerifyID(obj); err != nil {
+		return err
+	}
+
+	if err := VerifyIDSignature(obj, opts...); err != nil {
+		return errors.Wrap(err, "invalid identifier signature")
+	}
+
+	return VerifyPayloadChecksum(obj)
+}
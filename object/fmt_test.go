@@ -0,0 +1,59 @@
+package object_test
+
+import (
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/signature"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	key := test.DecodeKey(0)
+
+	obj := new(object.Object)
+	obj.SetHeader(generateHeader(10))
+	obj.SetPayload([]byte("Payload"))
+
+	require.NoError(t, object.SetVerificationFields(key, obj, signature.SignWithRFC6979()))
+	require.NoError(t, object.Verify(obj, signature.SignWithRFC6979()))
+
+	t.Run("incorrect identifier", func(t *testing.T) {
+		obj.GetHeader().SetCreationEpoch(obj.GetHeader().GetCreationEpoch() + 1)
+		defer obj.GetHeader().SetCreationEpoch(obj.GetHeader().GetCreationEpoch() - 1)
+
+		require.Equal(t, object.ErrIncorrectID, object.Verify(obj, signature.SignWithRFC6979()))
+	})
+
+	t.Run("incorrect signature", func(t *testing.T) {
+		sig := obj.GetSignature()
+		defer obj.SetSignature(sig)
+
+		sigOther, err := object.CalculateIDSignature(test.DecodeKey(1), obj.GetObjectID(), signature.SignWithRFC6979())
+		require.NoError(t, err)
+
+		sigOther.SetKey(sig.GetKey())
+		obj.SetSignature(sigOther)
+
+		require.Error(t, object.Verify(obj, signature.SignWithRFC6979()))
+	})
+
+	t.Run("incorrect payload length", func(t *testing.T) {
+		payload := obj.GetPayload()
+		defer obj.SetPayload(payload)
+
+		obj.SetPayload(append(payload, 1))
+
+		require.Equal(t, object.ErrIncorrectPayloadLength, object.Verify(obj, signature.SignWithRFC6979()))
+	})
+
+	t.Run("incorrect payload checksum", func(t *testing.T) {
+		payload := obj.GetPayload()
+		defer obj.SetPayload(payload)
+
+		obj.SetPayload([]byte("payload"))
+
+		require.Equal(t, object.ErrIncorrectPayloadChecksum, object.Verify(obj, signature.SignWithRFC6979()))
+	})
+}
@@ -1,10 +1,9 @@
 package object
 
 import (
-	object "github.com/cthulhu-rider/neofs-api-go/v2/object/grpc"
 	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/session"
 	"github.com/cthulhu-rider/neofs-api-go/v2/util/proto"
-	goproto "google.golang.org/protobuf/proto"
 )
 
 const (
@@ -192,15 +191,44 @@ func (h *ShortHeader) StableSize() (size int) {
 	return size
 }
 
-func (h *ShortHeader) Unmarshal(data []byte) error {
-	m := new(object.ShortHeader)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+func (h *ShortHeader) StableUnmarshal(data []byte) error {
+	if h == nil {
+		return nil
 	}
 
-	*h = *ShortHeaderFromGRPCMessage(m)
+	*h = ShortHeader{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case shortHdrVersionField:
+			h.version = new(refs.Version)
+			err = f.Nested(h.version)
+		case shortHdrEpochField:
+			h.creatEpoch, err = f.UInt64()
+		case shortHdrOwnerField:
+			h.ownerID = new(refs.OwnerID)
+			err = f.Nested(h.ownerID)
+		case shortHdrObjectTypeField:
+			var v int32
+
+			v, err = f.Enum()
+			h.typ = Type(v)
+		case shortHdrPayloadLength:
+			h.payloadLen, err = f.UInt64()
+		case shortHdrHashField:
+			h.payloadHash = new(refs.Checksum)
+			err = f.Nested(h.payloadHash)
+		case shortHdrHomoHashField:
+			h.homoHash = new(refs.Checksum)
+			err = f.Nested(h.homoHash)
+		}
+
+		return
+	})
+}
 
-	return nil
+func (h *ShortHeader) Unmarshal(data []byte) error {
+	return h.StableUnmarshal(data)
 }
 
 func (a *Attribute) StableMarshal(buf []byte) ([]byte, error) {
@@ -243,15 +271,27 @@ func (a *Attribute) StableSize() (size int) {
 	return size
 }
 
-func (a *Attribute) Unmarshal(data []byte) error {
-	m := new(object.Header_Attribute)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+func (a *Attribute) StableUnmarshal(data []byte) error {
+	if a == nil {
+		return nil
 	}
 
-	*a = *AttributeFromGRPCMessage(m)
+	*a = Attribute{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case attributeKeyField:
+			a.key, err = f.StringValue()
+		case attributeValueField:
+			a.val, err = f.StringValue()
+		}
 
-	return nil
+		return
+	})
+}
+
+func (a *Attribute) Unmarshal(data []byte) error {
+	return a.StableUnmarshal(data)
 }
 
 func (h *SplitHeader) StableMarshal(buf []byte) ([]byte, error) {
@@ -326,15 +366,42 @@ func (h *SplitHeader) StableSize() (size int) {
 	return size
 }
 
-func (h *SplitHeader) Unmarshal(data []byte) error {
-	m := new(object.Header_Split)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+func (h *SplitHeader) StableUnmarshal(data []byte) error {
+	if h == nil {
+		return nil
 	}
 
-	*h = *SplitHeaderFromGRPCMessage(m)
+	*h = SplitHeader{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case splitHdrParentField:
+			h.par = new(refs.ObjectID)
+			err = f.Nested(h.par)
+		case splitHdrPreviousField:
+			h.prev = new(refs.ObjectID)
+			err = f.Nested(h.prev)
+		case splitHdrParentSignatureField:
+			h.parSig = new(refs.Signature)
+			err = f.Nested(h.parSig)
+		case splitHdrParentHeaderField:
+			h.parHdr = new(Header)
+			err = f.Nested(h.parHdr)
+		case splitHdrChildrenField:
+			v := new(refs.ObjectID)
+			h.children = append(h.children, v)
+
+			err = f.Nested(v)
+		case splitHdrSplitIDField:
+			h.splitID, err = f.Bytes()
+		}
 
-	return nil
+		return
+	})
+}
+
+func (h *SplitHeader) Unmarshal(data []byte) error {
+	return h.StableUnmarshal(data)
 }
 
 func (h *Header) StableMarshal(buf []byte) ([]byte, error) {
@@ -453,15 +520,58 @@ func (h *Header) StableSize() (size int) {
 	return size
 }
 
-func (h *Header) Unmarshal(data []byte) error {
-	m := new(object.Header)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+func (h *Header) StableUnmarshal(data []byte) error {
+	if h == nil {
+		return nil
 	}
 
-	*h = *HeaderFromGRPCMessage(m)
+	*h = Header{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case hdrVersionField:
+			h.version = new(refs.Version)
+			err = f.Nested(h.version)
+		case hdrContainerIDField:
+			h.cid = new(refs.ContainerID)
+			err = f.Nested(h.cid)
+		case hdrOwnerIDField:
+			h.ownerID = new(refs.OwnerID)
+			err = f.Nested(h.ownerID)
+		case hdrEpochField:
+			h.creatEpoch, err = f.UInt64()
+		case hdrPayloadLengthField:
+			h.payloadLen, err = f.UInt64()
+		case hdrPayloadHashField:
+			h.payloadHash = new(refs.Checksum)
+			err = f.Nested(h.payloadHash)
+		case hdrObjectTypeField:
+			var v int32
+
+			v, err = f.Enum()
+			h.typ = Type(v)
+		case hdrHomomorphicHashField:
+			h.homoHash = new(refs.Checksum)
+			err = f.Nested(h.homoHash)
+		case hdrSessionTokenField:
+			h.sessionToken = new(session.SessionToken)
+			err = f.Nested(h.sessionToken)
+		case hdrAttributesField:
+			v := new(Attribute)
+			h.attr = append(h.attr, v)
+
+			err = f.Nested(v)
+		case hdrSplitField:
+			h.split = new(SplitHeader)
+			err = f.Nested(h.split)
+		}
 
-	return nil
+		return
+	})
+}
+
+func (h *Header) Unmarshal(data []byte) error {
+	return h.StableUnmarshal(data)
 }
 
 func (h *HeaderWithSignature) StableMarshal(buf []byte) ([]byte, error) {
@@ -504,15 +614,29 @@ func (h *HeaderWithSignature) StableSize() (size int) {
 	return size
 }
 
-func (h *HeaderWithSignature) Unmarshal(data []byte) error {
-	m := new(object.HeaderWithSignature)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+func (h *HeaderWithSignature) StableUnmarshal(data []byte) error {
+	if h == nil {
+		return nil
 	}
 
-	*h = *HeaderWithSignatureFromGRPCMessage(m)
+	*h = HeaderWithSignature{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case hdrWithSigHeaderField:
+			h.header = new(Header)
+			err = f.Nested(h.header)
+		case hdrWithSigSignatureField:
+			h.signature = new(refs.Signature)
+			err = f.Nested(h.signature)
+		}
+
+		return
+	})
+}
 
-	return nil
+func (h *HeaderWithSignature) Unmarshal(data []byte) error {
+	return h.StableUnmarshal(data)
 }
 
 func (o *Object) StableMarshal(buf []byte) ([]byte, error) {
@@ -576,25 +700,29 @@ func (o *Object) StableUnmarshal(data []byte) error {
 		return nil
 	}
 
-	objGRPC := new(object.Object)
-	if err := goproto.Unmarshal(data, objGRPC); err != nil {
-		return err
-	}
-
-	*o = *ObjectFromGRPCMessage(objGRPC)
+	*o = Object{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case objIDField:
+			o.objectID = new(refs.ObjectID)
+			err = f.Nested(o.objectID)
+		case objSignatureField:
+			o.idSig = new(refs.Signature)
+			err = f.Nested(o.idSig)
+		case objHeaderField:
+			o.header = new(Header)
+			err = f.Nested(o.header)
+		case objPayloadField:
+			o.payload, err = f.Bytes()
+		}
 
-	return nil
+		return
+	})
 }
 
 func (o *Object) Unmarshal(data []byte) error {
-	m := new(object.Object)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
-	}
-
-	*o = *ObjectFromGRPCMessage(m)
-
-	return nil
+	return o.StableUnmarshal(data)
 }
 
 func (s *SplitInfo) StableMarshal(buf []byte) ([]byte, error) {
@@ -645,15 +773,31 @@ func (s *SplitInfo) StableSize() (size int) {
 	return size
 }
 
-func (s *SplitInfo) Unmarshal(data []byte) error {
-	m := new(object.SplitInfo)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+func (s *SplitInfo) StableUnmarshal(data []byte) error {
+	if s == nil {
+		return nil
 	}
 
-	*s = *SplitInfoFromGRPCMessage(m)
+	*s = SplitInfo{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case splitInfoSplitIDField:
+			s.splitID, err = f.Bytes()
+		case splitInfoLastPartField:
+			s.lastPart = new(refs.ObjectID)
+			err = f.Nested(s.lastPart)
+		case splitInfoLinkField:
+			s.link = new(refs.ObjectID)
+			err = f.Nested(s.link)
+		}
 
-	return nil
+		return
+	})
+}
+
+func (s *SplitInfo) Unmarshal(data []byte) error {
+	return s.StableUnmarshal(data)
 }
 
 func (r *GetRequestBody) StableMarshal(buf []byte) ([]byte, error) {
@@ -696,6 +840,26 @@ func (r *GetRequestBody) StableSize() (size int) {
 	return size
 }
 
+func (r *GetRequestBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = GetRequestBody{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case getReqBodyAddressField:
+			r.addr = new(refs.Address)
+			err = f.Nested(r.addr)
+		case getReqBodyRawFlagField:
+			r.raw, err = f.Bool()
+		}
+
+		return
+	})
+}
+
 func (r *GetObjectPartInit) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -744,6 +908,30 @@ func (r *GetObjectPartInit) StableSize() (size int) {
 	return size
 }
 
+func (r *GetObjectPartInit) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = GetObjectPartInit{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case getRespInitObjectIDField:
+			r.id = new(refs.ObjectID)
+			err = f.Nested(r.id)
+		case getRespInitSignatureField:
+			r.sig = new(refs.Signature)
+			err = f.Nested(r.sig)
+		case getRespInitHeaderField:
+			r.hdr = new(Header)
+			err = f.Nested(r.hdr)
+		}
+
+		return
+	})
+}
+
 func (r *GetResponseBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -803,6 +991,36 @@ func (r *GetResponseBody) StableSize() (size int) {
 	return size
 }
 
+func (r *GetResponseBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = GetResponseBody{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case getRespBodyInitField:
+			v := new(GetObjectPartInit)
+			r.objPart = v
+
+			err = f.Nested(v)
+		case getRespBodyChunkField:
+			v := new(GetObjectPartChunk)
+			r.objPart = v
+
+			v.chunk, err = f.Bytes()
+		case getRespBodySplitInfoField:
+			v := new(SplitInfo)
+			r.objPart = v
+
+			err = f.Nested(v)
+		}
+
+		return
+	})
+}
+
 func (r *PutObjectPartInit) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -859,6 +1077,32 @@ func (r *PutObjectPartInit) StableSize() (size int) {
 	return size
 }
 
+func (r *PutObjectPartInit) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = PutObjectPartInit{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case putReqInitObjectIDField:
+			r.id = new(refs.ObjectID)
+			err = f.Nested(r.id)
+		case putReqInitSignatureField:
+			r.sig = new(refs.Signature)
+			err = f.Nested(r.sig)
+		case putReqInitHeaderField:
+			r.hdr = new(Header)
+			err = f.Nested(r.hdr)
+		case putReqInitCopiesNumField:
+			r.copyNum, err = f.UInt32()
+		}
+
+		return
+	})
+}
+
 func (r *PutRequestBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -911,6 +1155,31 @@ func (r *PutRequestBody) StableSize() (size int) {
 	return size
 }
 
+func (r *PutRequestBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = PutRequestBody{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case putReqBodyInitField:
+			v := new(PutObjectPartInit)
+			r.objPart = v
+
+			err = f.Nested(v)
+		case putReqBodyChunkField:
+			v := new(PutObjectPartChunk)
+			r.objPart = v
+
+			v.chunk, err = f.Bytes()
+		}
+
+		return
+	})
+}
+
 func (r *PutResponseBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -938,6 +1207,24 @@ func (r *PutResponseBody) StableSize() (size int) {
 	return size
 }
 
+func (r *PutResponseBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = PutResponseBody{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case putRespBodyObjectIDField:
+			r.id = new(refs.ObjectID)
+			err = f.Nested(r.id)
+		}
+
+		return
+	})
+}
+
 func (r *DeleteRequestBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -965,6 +1252,24 @@ func (r *DeleteRequestBody) StableSize() (size int) {
 	return size
 }
 
+func (r *DeleteRequestBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = DeleteRequestBody{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case deleteReqBodyAddressField:
+			r.addr = new(refs.Address)
+			err = f.Nested(r.addr)
+		}
+
+		return
+	})
+}
+
 func (r *DeleteResponseBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -992,6 +1297,24 @@ func (r *DeleteResponseBody) StableSize() (size int) {
 	return size
 }
 
+func (r *DeleteResponseBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = DeleteResponseBody{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case deleteRespBodyTombstoneFNum:
+			r.tombstone = new(refs.Address)
+			err = f.Nested(r.tombstone)
+		}
+
+		return
+	})
+}
+
 func (r *HeadRequestBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -1040,6 +1363,28 @@ func (r *HeadRequestBody) StableSize() (size int) {
 	return size
 }
 
+func (r *HeadRequestBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = HeadRequestBody{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case headReqBodyAddressField:
+			r.addr = new(refs.Address)
+			err = f.Nested(r.addr)
+		case headReqBodyMainFlagField:
+			r.mainOnly, err = f.Bool()
+		case headReqBodyRawFlagField:
+			r.raw, err = f.Bool()
+		}
+
+		return
+	})
+}
+
 func (r *HeadResponseBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -1107,6 +1452,36 @@ func (r *HeadResponseBody) StableSize() (size int) {
 	return size
 }
 
+func (r *HeadResponseBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = HeadResponseBody{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case headRespBodyHeaderField:
+			v := new(HeaderWithSignature)
+			r.hdrPart = v
+
+			err = f.Nested(v)
+		case headRespBodyShortHeaderField:
+			v := new(ShortHeader)
+			r.hdrPart = v
+
+			err = f.Nested(v)
+		case headRespBodySplitInfoField:
+			v := new(SplitInfo)
+			r.hdrPart = v
+
+			err = f.Nested(v)
+		}
+
+		return
+	})
+}
+
 func (f *SearchFilter) StableMarshal(buf []byte) ([]byte, error) {
 	if f == nil {
 		return []byte{}, nil
@@ -1155,6 +1530,30 @@ func (f *SearchFilter) StableSize() (size int) {
 	return size
 }
 
+func (f *SearchFilter) StableUnmarshal(data []byte) error {
+	if f == nil {
+		return nil
+	}
+
+	*f = SearchFilter{}
+
+	return proto.UnmarshalFields(data, func(fld proto.Field) (err error) {
+		switch fld.Num {
+		case searchFilterMatchField:
+			var v int32
+
+			v, err = fld.Enum()
+			f.matchType = MatchType(v)
+		case searchFilterNameField:
+			f.key, err = fld.StringValue()
+		case searchFilterValueField:
+			f.val, err = fld.StringValue()
+		}
+
+		return
+	})
+}
+
 func (r *SearchRequestBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -1210,6 +1609,31 @@ func (r *SearchRequestBody) StableSize() (size int) {
 	return size
 }
 
+func (r *SearchRequestBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = SearchRequestBody{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case searchReqBodyContainerIDField:
+			r.cid = new(refs.ContainerID)
+			err = f.Nested(r.cid)
+		case searchReqBodyVersionField:
+			r.version, err = f.UInt32()
+		case searchReqBodyFiltersField:
+			v := new(SearchFilter)
+			r.filters = append(r.filters, v)
+
+			err = f.Nested(v)
+		}
+
+		return
+	})
+}
+
 func (r *SearchResponseBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -1242,6 +1666,26 @@ func (r *SearchResponseBody) StableSize() (size int) {
 	return size
 }
 
+func (r *SearchResponseBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = SearchResponseBody{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case searchRespBodyObjectIDsField:
+			v := new(refs.ObjectID)
+			r.idList = append(r.idList, v)
+
+			err = f.Nested(v)
+		}
+
+		return
+	})
+}
+
 func (r *Range) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -1282,6 +1726,25 @@ func (r *Range) StableSize() (size int) {
 	return size
 }
 
+func (r *Range) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = Range{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case rangeOffsetField:
+			r.off, err = f.UInt64()
+		case rangeLengthField:
+			r.len, err = f.UInt64()
+		}
+
+		return
+	})
+}
+
 func (r *GetRangeRequestBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -1330,6 +1793,29 @@ func (r *GetRangeRequestBody) StableSize() (size int) {
 	return size
 }
 
+func (r *GetRangeRequestBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = GetRangeRequestBody{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case getRangeReqBodyAddressField:
+			r.addr = new(refs.Address)
+			err = f.Nested(r.addr)
+		case getRangeReqBodyRangeField:
+			r.rng = new(Range)
+			err = f.Nested(r.rng)
+		case getRangeReqBodyRawField:
+			r.raw, err = f.Bool()
+		}
+
+		return
+	})
+}
+
 func (r *GetRangeResponseBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -1386,6 +1872,31 @@ func (r *GetRangeResponseBody) StableSize() (size int) {
 	return size
 }
 
+func (r *GetRangeResponseBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = GetRangeResponseBody{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case getRangeRespChunkField:
+			v := new(GetRangePartChunk)
+			r.rngPart = v
+
+			v.chunk, err = f.Bytes()
+		case getRangeRespSplitInfoField:
+			v := new(SplitInfo)
+			r.rngPart = v
+
+			err = f.Nested(v)
+		}
+
+		return
+	})
+}
+
 func (r *GetRangeHashRequestBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -1448,6 +1959,36 @@ func (r *GetRangeHashRequestBody) StableSize() (size int) {
 	return size
 }
 
+func (r *GetRangeHashRequestBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = GetRangeHashRequestBody{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case getRangeHashReqBodyAddressField:
+			r.addr = new(refs.Address)
+			err = f.Nested(r.addr)
+		case getRangeHashReqBodyRangesField:
+			v := new(Range)
+			r.rngs = append(r.rngs, v)
+
+			err = f.Nested(v)
+		case getRangeHashReqBodySaltField:
+			r.salt, err = f.Bytes()
+		case getRangeHashReqBodyTypeField:
+			var v int32
+
+			v, err = f.Enum()
+			r.typ = refs.ChecksumType(v)
+		}
+
+		return
+	})
+}
+
 func (r *GetRangeHashResponseBody) StableMarshal(buf []byte) ([]byte, error) {
 	if r == nil {
 		return []byte{}, nil
@@ -1487,3 +2028,28 @@ func (r *GetRangeHashResponseBody) StableSize() (size int) {
 
 	return size
 }
+
+func (r *GetRangeHashResponseBody) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = GetRangeHashResponseBody{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case getRangeHashRespBodyTypeField:
+			var v int32
+
+			v, err = f.Enum()
+			r.typ = refs.ChecksumType(v)
+		case getRangeHashRespBodyHashListField:
+			var v []byte
+
+			v, err = f.Bytes()
+			r.hashList = append(r.hashList, v)
+		}
+
+		return
+	})
+}
@@ -103,6 +103,10 @@ func TestGetRequestBody_StableMarshal(t *testing.T) {
 
 		to := object.GetRequestBodyFromGRPCMessage(transport)
 		require.Equal(t, from, to)
+
+		to = new(object.GetRequestBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, from, to)
 	})
 }
 
@@ -121,6 +125,10 @@ func TestGetResponseBody_StableMarshal(t *testing.T) {
 
 		to := object.GetResponseBodyFromGRPCMessage(transport)
 		require.Equal(t, initFrom, to)
+
+		to = new(object.GetResponseBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, initFrom, to)
 	})
 
 	t.Run("chunk non empty", func(t *testing.T) {
@@ -132,6 +140,10 @@ func TestGetResponseBody_StableMarshal(t *testing.T) {
 
 		to := object.GetResponseBodyFromGRPCMessage(transport)
 		require.Equal(t, chunkFrom, to)
+
+		to = new(object.GetResponseBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, chunkFrom, to)
 	})
 
 	t.Run("split info non empty", func(t *testing.T) {
@@ -143,6 +155,10 @@ func TestGetResponseBody_StableMarshal(t *testing.T) {
 
 		to := object.GetResponseBodyFromGRPCMessage(transport)
 		require.Equal(t, splitInfoFrom, to)
+
+		to = new(object.GetResponseBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, splitInfoFrom, to)
 	})
 }
 
@@ -160,6 +176,10 @@ func TestPutRequestBody_StableMarshal(t *testing.T) {
 
 		to := object.PutRequestBodyFromGRPCMessage(transport)
 		require.Equal(t, initFrom, to)
+
+		to = new(object.PutRequestBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, initFrom, to)
 	})
 
 	t.Run("chunk non empty", func(t *testing.T) {
@@ -171,6 +191,10 @@ func TestPutRequestBody_StableMarshal(t *testing.T) {
 
 		to := object.PutRequestBodyFromGRPCMessage(transport)
 		require.Equal(t, chunkFrom, to)
+
+		to = new(object.PutRequestBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, chunkFrom, to)
 	})
 }
 
@@ -187,6 +211,10 @@ func TestPutRequestBody_StableSize(t *testing.T) {
 
 		to := object.PutResponseBodyFromGRPCMessage(transport)
 		require.Equal(t, from, to)
+
+		to = new(object.PutResponseBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, from, to)
 	})
 }
 
@@ -203,6 +231,10 @@ func TestDeleteRequestBody_StableMarshal(t *testing.T) {
 
 		to := object.DeleteRequestBodyFromGRPCMessage(transport)
 		require.Equal(t, from, to)
+
+		to = new(object.DeleteRequestBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, from, to)
 	})
 }
 
@@ -219,6 +251,10 @@ func TestDeleteResponseBody_StableMarshal(t *testing.T) {
 
 		to := object.DeleteResponseBodyFromGRPCMessage(transport)
 		require.Equal(t, from, to)
+
+		to = new(object.DeleteResponseBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, from, to)
 	})
 }
 
@@ -235,6 +271,10 @@ func TestSplitHeaderFromGRPCMessage(t *testing.T) {
 
 		to := object.HeadRequestBodyFromGRPCMessage(transport)
 		require.Equal(t, from, to)
+
+		to = new(object.HeadRequestBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, from, to)
 	})
 }
 
@@ -253,6 +293,10 @@ func TestHeadResponseBody_StableMarshal(t *testing.T) {
 
 		to := object.HeadResponseBodyFromGRPCMessage(transport)
 		require.Equal(t, shortFrom, to)
+
+		to = new(object.HeadResponseBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, shortFrom, to)
 	})
 
 	t.Run("full header non empty", func(t *testing.T) {
@@ -264,6 +308,10 @@ func TestHeadResponseBody_StableMarshal(t *testing.T) {
 
 		to := object.HeadResponseBodyFromGRPCMessage(transport)
 		require.Equal(t, fullFrom, to)
+
+		to = new(object.HeadResponseBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, fullFrom, to)
 	})
 
 	t.Run("split info non empty", func(t *testing.T) {
@@ -275,6 +323,10 @@ func TestHeadResponseBody_StableMarshal(t *testing.T) {
 
 		to := object.HeadResponseBodyFromGRPCMessage(transport)
 		require.Equal(t, splitInfoFrom, to)
+
+		to = new(object.HeadResponseBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, splitInfoFrom, to)
 	})
 }
 
@@ -291,6 +343,10 @@ func TestSearchRequestBody_StableMarshal(t *testing.T) {
 
 		to := object.SearchRequestBodyFromGRPCMessage(transport)
 		require.Equal(t, from, to)
+
+		to = new(object.SearchRequestBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, from, to)
 	})
 }
 
@@ -307,6 +363,10 @@ func TestSearchResponseBody_StableMarshal(t *testing.T) {
 
 		to := object.SearchResponseBodyFromGRPCMessage(transport)
 		require.Equal(t, from, to)
+
+		to = new(object.SearchResponseBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, from, to)
 	})
 }
 
@@ -323,6 +383,10 @@ func TestGetRangeRequestBody_StableMarshal(t *testing.T) {
 
 		to := object.GetRangeRequestBodyFromGRPCMessage(transport)
 		require.Equal(t, from, to)
+
+		to = new(object.GetRangeRequestBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, from, to)
 	})
 }
 
@@ -340,6 +404,10 @@ func TestGetRangeResponseBody_StableMarshal(t *testing.T) {
 
 		to := object.GetRangeResponseBodyFromGRPCMessage(transport)
 		require.Equal(t, dataFrom, to)
+
+		to = new(object.GetRangeResponseBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, dataFrom, to)
 	})
 
 	t.Run("split info non empty", func(t *testing.T) {
@@ -351,6 +419,10 @@ func TestGetRangeResponseBody_StableMarshal(t *testing.T) {
 
 		to := object.GetRangeResponseBodyFromGRPCMessage(transport)
 		require.Equal(t, splitInfoFrom, to)
+
+		to = new(object.GetRangeResponseBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, splitInfoFrom, to)
 	})
 }
 
@@ -367,6 +439,10 @@ func TestGetRangeHashRequestBody_StableMarshal(t *testing.T) {
 
 		to := object.GetRangeHashRequestBodyFromGRPCMessage(transport)
 		require.Equal(t, from, to)
+
+		to = new(object.GetRangeHashRequestBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, from, to)
 	})
 }
 
@@ -383,6 +459,10 @@ func TestGetRangeHashResponseBody_StableMarshal(t *testing.T) {
 
 		to := object.GetRangeHashResponseBodyFromGRPCMessage(transport)
 		require.Equal(t, from, to)
+
+		to = new(object.GetRangeHashResponseBody)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, from, to)
 	})
 }
 
@@ -764,6 +844,11 @@ func TestObject_StableUnmarshal(t *testing.T) {
 	require.NoError(t, obj2.StableUnmarshal(data))
 
 	require.Equal(t, obj, obj2)
+
+	data2, err := obj2.StableMarshal(nil)
+	require.NoError(t, err)
+
+	require.Equal(t, data, data2)
 }
 
 func generateSplitInfo() *object.SplitInfo {
@@ -0,0 +1,174 @@
+package object
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/mr-tron/base58"
+)
+
+// SearchFilterMismatchError describes the filter which rejected the object
+// in MatchSearchFilters.
+type SearchFilterMismatchError struct {
+	// Index is an index of the filter in the list.
+	Index int
+
+	// Filter is a filter which rejected the object.
+	Filter *SearchFilter
+
+	// Reason describes why the object was rejected.
+	Reason string
+}
+
+func (e *SearchFilterMismatchError) Error() string {
+	return fmt.Sprintf("filter #%d (%s) rejected the object: %s", e.Index, e.Filter.GetKey(), e.Reason)
+}
+
+// MatchSearchFilters checks whether the object with the identifier and the
+// header matches all search filters.
+//
+// Values of the object header fields are compared in the following
+// canonical encodings:
+//   - version as vMAJOR.MINOR;
+//   - object, container, owner and split parent identifiers in base58;
+//   - creation epoch and payload length in decimal;
+//   - payload checksums in hex;
+//   - object type as returned by Type.String;
+//   - split ID as UUID string if it has 16 bytes, hex otherwise.
+//
+// Other keys are compared with values of the object attributes. Unset fields
+// and missing attributes are considered not present.
+//
+// Boolean properties FilterPropertyRoot and FilterPropertyPhy are always
+// present with BooleanPropertyValueTrue or BooleanPropertyValueFalse value.
+// Object is a root object if its header has no split header. Physical
+// presence of the object cannot be derived from the header, so it is
+// provided by phy argument.
+//
+// Semantics of match types:
+//   - MatchStringEqual matches present values equal to the filter value;
+//   - MatchStringNotEqual matches present values not equal to the filter value;
+//   - MatchNotPresent matches absent keys;
+//   - other match types never match.
+//
+// Returns nil if the object matches, *SearchFilterMismatchError otherwise.
+func MatchSearchFilters(id *refs.ObjectID, hdr *Header, fs []*SearchFilter, phy bool) error {
+	for i := range fs {
+		if reason := matchSearchFilter(id, hdr, fs[i], phy); reason != "" {
+			return &SearchFilterMismatchError{
+				Index:  i,
+				Filter: fs[i],
+				Reason: reason,
+			}
+		}
+	}
+
+	return nil
+}
+
+func matchSearchFilter(id *refs.ObjectID, hdr *Header, f *SearchFilter, phy bool) string {
+	val, ok := searchValue(id, hdr, f.GetKey(), phy)
+
+	switch typ := f.GetMatchType(); typ {
+	case MatchStringEqual:
+		switch {
+		case !ok:
+			return "key is not present"
+		case val != f.GetValue():
+			return fmt.Sprintf("value %q is not equal to %q", val, f.GetValue())
+		}
+	case MatchStringNotEqual:
+		switch {
+		case !ok:
+			return "key is not present"
+		case val == f.GetValue():
+			return fmt.Sprintf("value is equal to %q", f.GetValue())
+		}
+	case MatchNotPresent:
+		if ok {
+			return "key is present"
+		}
+	default:
+		return fmt.Sprintf("unknown match type %d", typ)
+	}
+
+	return ""
+}
+
+func searchValue(id *refs.ObjectID, hdr *Header, key string, phy bool) (string, bool) {
+	switch key {
+	case FilterHeaderVersion:
+		v := hdr.GetVersion()
+		if v == nil {
+			return "", false
+		}
+
+		return fmt.Sprintf("v%d.%d", v.GetMajor(), v.GetMinor()), true
+	case FilterHeaderObjectID:
+		return base58Value(id.GetValue())
+	case FilterHeaderContainerID:
+		return base58Value(hdr.GetContainerID().GetValue())
+	case FilterHeaderOwnerID:
+		return base58Value(hdr.GetOwnerID().GetValue())
+	case FilterHeaderCreationEpoch:
+		return strconv.FormatUint(hdr.GetCreationEpoch(), 10), hdr != nil
+	case FilterHeaderPayloadLength:
+		return strconv.FormatUint(hdr.GetPayloadLength(), 10), hdr != nil
+	case FilterHeaderPayloadHash:
+		return hexValue(hdr.GetPayloadHash().GetSum())
+	case FilterHeaderObjectType:
+		return hdr.GetObjectType().String(), hdr != nil
+	case FilterHeaderHomomorphicHash:
+		return hexValue(hdr.GetHomomorphicHash().GetSum())
+	case FilterHeaderParent:
+		return base58Value(hdr.GetSplit().GetParent().GetValue())
+	case FilterHeaderSplitID:
+		return splitIDValue(hdr.GetSplit().GetSplitID())
+	case FilterPropertyRoot:
+		return booleanValue(hdr.GetSplit() == nil), true
+	case FilterPropertyPhy:
+		return booleanValue(phy), true
+	}
+
+	for _, a := range hdr.GetAttributes() {
+		if a.GetKey() == key {
+			return a.GetValue(), true
+		}
+	}
+
+	return "", false
+}
+
+func base58Value(v []byte) (string, bool) {
+	if len(v) == 0 {
+		return "", false
+	}
+
+	return base58.Encode(v), true
+}
+
+func hexValue(v []byte) (string, bool) {
+	if len(v) == 0 {
+		return "", false
+	}
+
+	return hex.EncodeToString(v), true
+}
+
+func splitIDValue(v []byte) (string, bool) {
+	if len(v) != 16 {
+		return hexValue(v)
+	}
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", v[:4], v[4:6], v[6:8], v[8:10], v[10:]), true
+}
+
+func booleanValue(v bool) string {
+	if v {
+		return BooleanPropertyValueTrue
+	}
+
+	return BooleanPropertyValueFalse
+}
@@ -0,0 +1,96 @@
+package object_test
+
+import (
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchSearchFilters(t *testing.T) {
+	id := new(refs.ObjectID)
+	id.SetValue([]byte("Object ID"))
+
+	ver := new(refs.Version)
+	ver.SetMajor(2)
+	ver.SetMinor(1)
+
+	cid := new(refs.ContainerID)
+	cid.SetValue([]byte("Container ID"))
+
+	parent := new(refs.ObjectID)
+	parent.SetValue([]byte("Parent ID"))
+
+	payloadHash := new(refs.Checksum)
+	payloadHash.SetSum([]byte{1, 2, 3})
+
+	attr := new(object.Attribute)
+	attr.SetKey("FileName")
+	attr.SetValue("a.txt")
+
+	split := new(object.SplitHeader)
+	split.SetParent(parent)
+	split.SetSplitID([]byte{
+		0x0f, 0x3e, 0x41, 0x3a, 0x4f, 0x4a, 0x4b, 0x4c,
+		0x8d, 0x1b, 0x4f, 0x8e, 0x1a, 0x7c, 0x6e, 0x2d,
+	})
+
+	hdr := new(object.Header)
+	hdr.SetVersion(ver)
+	hdr.SetContainerID(cid)
+	hdr.SetCreationEpoch(13)
+	hdr.SetPayloadLength(0)
+	hdr.SetPayloadHash(payloadHash)
+	hdr.SetObjectType(object.TypeTombstone)
+	hdr.SetAttributes([]*object.Attribute{attr})
+	hdr.SetSplit(split)
+
+	for _, f := range []*object.SearchFilter{
+		searchFilter(object.FilterHeaderVersion, object.MatchStringEqual, "v2.1"),
+		searchFilter(object.FilterHeaderObjectID, object.MatchStringEqual, base58.Encode(id.GetValue())),
+		searchFilter(object.FilterHeaderContainerID, object.MatchStringEqual, base58.Encode(cid.GetValue())),
+		searchFilter(object.FilterHeaderOwnerID, object.MatchNotPresent, ""),
+		searchFilter(object.FilterHeaderCreationEpoch, object.MatchStringEqual, "13"),
+		searchFilter(object.FilterHeaderPayloadLength, object.MatchStringEqual, "0"),
+		searchFilter(object.FilterHeaderPayloadHash, object.MatchStringEqual, "010203"),
+		searchFilter(object.FilterHeaderObjectType, object.MatchStringEqual, "Tombstone"),
+		searchFilter(object.FilterHeaderHomomorphicHash, object.MatchNotPresent, ""),
+		searchFilter(object.FilterHeaderParent, object.MatchStringEqual, base58.Encode(parent.GetValue())),
+		searchFilter(object.FilterHeaderSplitID, object.MatchStringEqual, "0f3e413a-4f4a-4b4c-8d1b-4f8e1a7c6e2d"),
+		searchFilter(object.FilterPropertyRoot, object.MatchStringEqual, object.BooleanPropertyValueFalse),
+		searchFilter(object.FilterPropertyPhy, object.MatchStringEqual, object.BooleanPropertyValueTrue),
+		searchFilter("FileName", object.MatchStringEqual, "a.txt"),
+		searchFilter("FileName", object.MatchStringNotEqual, "b.txt"),
+		searchFilter("Expires", object.MatchNotPresent, ""),
+	} {
+		require.NoError(t, object.MatchSearchFilters(id, hdr, []*object.SearchFilter{f}, true), f.GetKey())
+	}
+
+	fs := []*object.SearchFilter{
+		searchFilter("FileName", object.MatchStringEqual, "a.txt"),
+		searchFilter(object.FilterPropertyRoot, object.MatchStringEqual, object.BooleanPropertyValueTrue),
+	}
+
+	err := object.MatchSearchFilters(id, hdr, fs, true)
+
+	e, ok := err.(*object.SearchFilterMismatchError)
+	require.True(t, ok)
+	require.Equal(t, 1, e.Index)
+	require.Equal(t, fs[1], e.Filter)
+
+	hdr.SetSplit(nil)
+	require.NoError(t, object.MatchSearchFilters(id, hdr, fs, false))
+
+	for _, f := range []*object.SearchFilter{
+		searchFilter("FileName", object.MatchStringNotEqual, "a.txt"),
+		searchFilter("Expires", object.MatchStringNotEqual, "a.txt"),
+		searchFilter("FileName", object.MatchNotPresent, ""),
+		searchFilter(object.FilterPropertyPhy, object.MatchStringEqual, object.BooleanPropertyValueTrue),
+		searchFilter(object.FilterPropertyRoot, object.MatchNotPresent, ""),
+		searchFilter("FileName", object.MatchUnknown, "a.txt"),
+	} {
+		require.Error(t, object.MatchSearchFilters(id, hdr, []*object.SearchFilter{f}, false), f.GetKey())
+	}
+}
@@ -0,0 +1,339 @@
+package object
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// QuerySyntaxError describes the failure of search query parsing.
+type QuerySyntaxError struct {
+	// Column is a 1-based position of the failing character in the query.
+	Column int
+
+	// Msg describes the failure.
+	Msg string
+}
+
+func (e *QuerySyntaxError) Error() string {
+	return fmt.Sprintf("column %d: %s", e.Column, e.Msg)
+}
+
+const (
+	queryAnd      = "AND"
+	queryEqual    = "=="
+	queryNotEqual = "!="
+	queryNot      = "!"
+)
+
+type queryTokenType uint8
+
+const (
+	queryTokenEOF queryTokenType = iota
+	queryTokenWord
+	queryTokenString
+	queryTokenEqual
+	queryTokenNotEqual
+	queryTokenNot
+)
+
+type queryToken struct {
+	typ queryTokenType
+
+	// value of the word or unquoted string
+	val string
+
+	// byte offset in the query
+	pos int
+}
+
+type queryParser struct {
+	s string
+
+	pos int
+
+	tok queryToken
+}
+
+// ParseSearchFilters parses search query and returns the list of filters.
+//
+// Query is a list of conditions joined with AND keyword:
+//
+//	FileName == "a.txt" AND $Object:ROOT AND !Expires
+//
+// Supported conditions are:
+//   - key == value for MatchStringEqual;
+//   - key != value for MatchStringNotEqual;
+//   - !key for MatchNotPresent;
+//   - key for boolean properties FilterPropertyRoot and FilterPropertyPhy.
+//
+// Keys and values are either bare words or double-quoted strings
+// with Go escape sequences. Empty query results in empty filter list.
+//
+// Returns *QuerySyntaxError if query is malformed.
+func ParseSearchFilters(s string) ([]*SearchFilter, error) {
+	p := &queryParser{s: s}
+
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	if p.tok.typ == queryTokenEOF {
+		return nil, nil
+	}
+
+	var fs []*SearchFilter
+
+	for {
+		f, err := p.parseFilter()
+		if err != nil {
+			return nil, err
+		}
+
+		fs = append(fs, f)
+
+		switch {
+		case p.tok.typ == queryTokenEOF:
+			return fs, nil
+		case p.tok.typ == queryTokenWord && p.tok.val == queryAnd:
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, p.errorf(p.tok.pos, "expected %s or end of query", queryAnd)
+		}
+	}
+}
+
+func (p *queryParser) parseFilter() (*SearchFilter, error) {
+	f := new(SearchFilter)
+
+	if p.tok.typ == queryTokenNot {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		key, err := p.parseOperand("key")
+		if err != nil {
+			return nil, err
+		}
+
+		f.SetKey(key)
+		f.SetMatchType(MatchNotPresent)
+
+		return f, nil
+	}
+
+	key, err := p.parseOperand("key")
+	if err != nil {
+		return nil, err
+	}
+
+	f.SetKey(key)
+
+	switch p.tok.typ {
+	case queryTokenEqual:
+		f.SetMatchType(MatchStringEqual)
+	case queryTokenNotEqual:
+		f.SetMatchType(MatchStringNotEqual)
+	default:
+		if !isBooleanProperty(key) {
+			return nil, p.errorf(p.tok.pos, "expected %s or %s after key", queryEqual, queryNotEqual)
+		}
+
+		f.SetMatchType(MatchStringEqual)
+		f.SetValue(BooleanPropertyValueTrue)
+
+		return f, nil
+	}
+
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	val, err := p.parseOperand("value")
+	if err != nil {
+		return nil, err
+	}
+
+	f.SetValue(val)
+
+	return f, nil
+}
+
+func (p *queryParser) parseOperand(name string) (string, error) {
+	switch p.tok.typ {
+	case queryTokenWord:
+		if p.tok.val == queryAnd {
+			return "", p.errorf(p.tok.pos, "unexpected keyword %s, expected %s", queryAnd, name)
+		}
+
+		fallthrough
+	case queryTokenString:
+		val := p.tok.val
+
+		return val, p.next()
+	case queryTokenEOF:
+		return "", p.errorf(p.tok.pos, "unexpected end of query, expected %s", name)
+	default:
+		return "", p.errorf(p.tok.pos, "expected %s", name)
+	}
+}
+
+func (p *queryParser) errorf(pos int, format string, args ...interface{}) error {
+	return &QuerySyntaxError{
+		Column: utf8.RuneCountInString(p.s[:pos]) + 1,
+		Msg:    fmt.Sprintf(format, args...),
+	}
+}
+
+// next reads the next token of the query.
+func (p *queryParser) next() error {
+	for p.pos < len(p.s) {
+		r, size := utf8.DecodeRuneInString(p.s[p.pos:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+
+		p.pos += size
+	}
+
+	p.tok = queryToken{pos: p.pos}
+
+	if p.pos == len(p.s) {
+		p.tok.typ = queryTokenEOF
+		return nil
+	}
+
+	rest := p.s[p.pos:]
+
+	switch {
+	case strings.HasPrefix(rest, queryEqual):
+		p.tok.typ = queryTokenEqual
+		p.pos += len(queryEqual)
+	case strings.HasPrefix(rest, queryNotEqual):
+		p.tok.typ = queryTokenNotEqual
+		p.pos += len(queryNotEqual)
+	case strings.HasPrefix(rest, queryNot):
+		p.tok.typ = queryTokenNot
+		p.pos += len(queryNot)
+	case rest[0] == '"':
+		return p.readString()
+	default:
+		return p.readWord()
+	}
+
+	return nil
+}
+
+func (p *queryParser) readString() error {
+	for i := p.pos + 1; i < len(p.s); i++ {
+		switch p.s[i] {
+		case '\\':
+			i++
+		case '"':
+			val, err := strconv.Unquote(p.s[p.pos : i+1])
+			if err != nil {
+				return p.errorf(p.pos, "invalid string: %v", errors.Cause(err))
+			}
+
+			p.tok.typ = queryTokenString
+			p.tok.val = val
+			p.pos = i + 1
+
+			return nil
+		}
+	}
+
+	return p.errorf(p.pos, "unterminated string")
+}
+
+func (p *queryParser) readWord() error {
+	start := p.pos
+
+	for p.pos < len(p.s) {
+		r, size := utf8.DecodeRuneInString(p.s[p.pos:])
+		if !isQueryWordRune(r) {
+			break
+		}
+
+		p.pos += size
+	}
+
+	if p.pos == start {
+		return p.errorf(start, "unexpected character %q", p.s[start:start+1])
+	}
+
+	p.tok.typ = queryTokenWord
+	p.tok.val = p.s[start:p.pos]
+
+	return nil
+}
+
+func isQueryWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_.:$-/+@", r)
+}
+
+func isQueryWord(s string) bool {
+	if s == "" || s == queryAnd {
+		return false
+	}
+
+	for _, r := range s {
+		if !isQueryWordRune(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isBooleanProperty(key string) bool {
+	return key == FilterPropertyRoot || key == FilterPropertyPhy
+}
+
+func formatQueryOperand(s string) string {
+	if isQueryWord(s) {
+		return s
+	}
+
+	return strconv.Quote(s)
+}
+
+// FormatSearchFilters returns search query of the filter list
+// in the format of ParseSearchFilters.
+//
+// Returns an error if filter with unsupported match type is met.
+func FormatSearchFilters(fs []*SearchFilter) (string, error) {
+	b := new(strings.Builder)
+
+	for i := range fs {
+		if i > 0 {
+			b.WriteString(" " + queryAnd + " ")
+		}
+
+		key := formatQueryOperand(fs[i].GetKey())
+
+		switch typ := fs[i].GetMatchType(); typ {
+		case MatchStringEqual:
+			if isBooleanProperty(fs[i].GetKey()) && fs[i].GetValue() == BooleanPropertyValueTrue {
+				b.WriteString(key)
+				continue
+			}
+
+			b.WriteString(key + " " + queryEqual + " " + strconv.Quote(fs[i].GetValue()))
+		case MatchStringNotEqual:
+			b.WriteString(key + " " + queryNotEqual + " " + strconv.Quote(fs[i].GetValue()))
+		case MatchNotPresent:
+			b.WriteString(queryNot + key)
+		default:
+			return "", errors.Errorf("unsupported match type %d of filter #%d", typ, i)
+		}
+	}
+
+	return b.String(), nil
+}
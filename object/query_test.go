@@ -0,0 +1,79 @@
+package object_test
+
+import (
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/stretchr/testify/require"
+)
+
+func searchFilter(key string, typ object.MatchType, val string) *object.SearchFilter {
+	f := new(object.SearchFilter)
+	f.SetKey(key)
+	f.SetMatchType(typ)
+	f.SetValue(val)
+
+	return f
+}
+
+func TestParseSearchFilters(t *testing.T) {
+	fs, err := object.ParseSearchFilters(`FileName == "a.txt" AND $Object:ROOT AND !Expires AND "my key" != value-1`)
+	require.NoError(t, err)
+	require.Equal(t, []*object.SearchFilter{
+		searchFilter("FileName", object.MatchStringEqual, "a.txt"),
+		searchFilter(object.FilterPropertyRoot, object.MatchStringEqual, object.BooleanPropertyValueTrue),
+		searchFilter("Expires", object.MatchNotPresent, ""),
+		searchFilter("my key", object.MatchStringNotEqual, "value-1"),
+	}, fs)
+
+	fs, err = object.ParseSearchFilters("  ")
+	require.NoError(t, err)
+	require.Empty(t, fs)
+
+	t.Run("errors", func(t *testing.T) {
+		for _, tc := range []struct {
+			query  string
+			column int
+		}{
+			{query: `FileName`, column: 9},
+			{query: `FileName ==`, column: 12},
+			{query: `FileName == "a.txt`, column: 13},
+			{query: `FileName == "a\q"`, column: 13},
+			{query: `FileName == a OR b == c`, column: 15},
+			{query: `a == b AND`, column: 11},
+			{query: `AND a == b`, column: 1},
+			{query: `a == b AND # == c`, column: 12},
+			{query: `ключ == b AND !`, column: 16},
+		} {
+			_, err := object.ParseSearchFilters(tc.query)
+
+			e, ok := err.(*object.QuerySyntaxError)
+			require.True(t, ok, tc.query)
+			require.Equal(t, tc.column, e.Column, tc.query)
+		}
+	})
+}
+
+func TestFormatSearchFilters(t *testing.T) {
+	fs := []*object.SearchFilter{
+		searchFilter("FileName", object.MatchStringEqual, "a.txt"),
+		searchFilter(object.FilterPropertyPhy, object.MatchStringEqual, object.BooleanPropertyValueTrue),
+		searchFilter(object.FilterPropertyRoot, object.MatchStringNotEqual, object.BooleanPropertyValueTrue),
+		searchFilter("Expires", object.MatchNotPresent, ""),
+		searchFilter("AND", object.MatchStringEqual, "\"quoted\"\n"),
+		searchFilter("", object.MatchStringEqual, ""),
+	}
+
+	s, err := object.FormatSearchFilters(fs)
+	require.NoError(t, err)
+	require.Equal(t, `FileName == "a.txt" AND $Object:PHY AND $Object:ROOT != "true" AND !Expires AND "AND" == "\"quoted\"\n" AND "" == ""`, s)
+
+	res, err := object.ParseSearchFilters(s)
+	require.NoError(t, err)
+	require.Equal(t, fs, res)
+
+	_, err = object.FormatSearchFilters([]*object.SearchFilter{
+		searchFilter("key", object.MatchUnknown, "value"),
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,149 @@
+package object
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/tz"
+	"github.com/pkg/errors"
+)
+
+// ErrRangeOutOfBounds is returned when payload range exceeds
+// the payload.
+var ErrRangeOutOfBounds = errors.New("payload range is out of bounds")
+
+// RangeHashMismatchError describes the ranges which hashes in
+// GetRangeHash response differ from the expected ones.
+type RangeHashMismatchError struct {
+	// Indices contains indices of mismatched ranges in the request.
+	Indices []int
+}
+
+func (e *RangeHashMismatchError) Error() string {
+	idx := make([]string, 0, len(e.Indices))
+
+	for _, i := range e.Indices {
+		idx = append(idx, fmt.Sprintf("#%d", i))
+	}
+
+	return "hash mismatch in ranges " + strings.Join(idx, ", ")
+}
+
+// CalculateRangeHashes calculates hashes of the payload ranges of the
+// specified type.
+//
+// If salt is not empty, it is cyclically XOR'ed to the data of each range
+// before hashing. Supported types are SHA256 and TillichZemor.
+//
+// Returns an error wrapping ErrRangeOutOfBounds if any range exceeds the
+// payload and ErrUnsupportedChecksum if type is not supported.
+func CalculateRangeHashes(payload []byte, rngs []*Range, salt []byte, typ refs.ChecksumType) ([][]byte, error) {
+	var hash func([]byte) []byte
+
+	switch typ {
+	case refs.SHA256:
+		hash = func(data []byte) []byte {
+			sum := sha256.Sum256(data)
+			return sum[:]
+		}
+	case refs.TillichZemor:
+		hash = func(data []byte) []byte {
+			sum := tz.Sum(data)
+			return sum[:]
+		}
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedChecksum, "%d", typ)
+	}
+
+	res := make([][]byte, 0, len(rngs))
+
+	for i := range rngs {
+		data, err := payloadRange(payload, rngs[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "range #%d", i)
+		}
+
+		res = append(res, hash(saltXOR(data, salt)))
+	}
+
+	return res, nil
+}
+
+// CalculateRangeHashResponse calculates GetRangeHash response body to the
+// request body from the object payload.
+func CalculateRangeHashResponse(payload []byte, req *GetRangeHashRequestBody) (*GetRangeHashResponseBody, error) {
+	hashes, err := CalculateRangeHashes(payload, req.GetRanges(), req.GetSalt(), req.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(GetRangeHashResponseBody)
+	resp.SetType(req.GetType())
+	resp.SetHashList(hashes)
+
+	return resp, nil
+}
+
+// VerifyRangeHashResponse checks that GetRangeHash response body contains
+// correct hashes of the payload ranges from the request body.
+//
+// Returns *RangeHashMismatchError if some hashes are incorrect.
+func VerifyRangeHashResponse(payload []byte, req *GetRangeHashRequestBody, resp *GetRangeHashResponseBody) error {
+	if resp.GetType() != req.GetType() {
+		return errors.Errorf("checksum type mismatch: expected %d, got %d", req.GetType(), resp.GetType())
+	}
+
+	exp, err := CalculateRangeHashes(payload, req.GetRanges(), req.GetSalt(), req.GetType())
+	if err != nil {
+		return err
+	}
+
+	got := resp.GetHashList()
+	if len(got) != len(exp) {
+		return errors.Errorf("hash number mismatch: expected %d, got %d", len(exp), len(got))
+	}
+
+	var mismatched []int
+
+	for i := range exp {
+		if !bytes.Equal(exp[i], got[i]) {
+			mismatched = append(mismatched, i)
+		}
+	}
+
+	if len(mismatched) > 0 {
+		return &RangeHashMismatchError{
+			Indices: mismatched,
+		}
+	}
+
+	return nil
+}
+
+func payloadRange(payload []byte, rng *Range) ([]byte, error) {
+	off, ln := rng.GetOffset(), rng.GetLength()
+
+	if off > uint64(len(payload)) || ln > uint64(len(payload))-off {
+		return nil, errors.Wrapf(ErrRangeOutOfBounds, "[%d:%d] of %d bytes", off, off+ln, len(payload))
+	}
+
+	return payload[off : off+ln], nil
+}
+
+// saltXOR returns data cyclically XOR'ed with salt.
+func saltXOR(data, salt []byte) []byte {
+	if len(salt) == 0 {
+		return data
+	}
+
+	res := make([]byte, len(data))
+
+	for i := range data {
+		res[i] = data[i] ^ salt[i%len(salt)]
+	}
+
+	return res
+}
@@ -0,0 +1,105 @@
+package object_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/tz"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func newRange(off, ln uint64) *object.Range {
+	rng := new(object.Range)
+	rng.SetOffset(off)
+	rng.SetLength(ln)
+
+	return rng
+}
+
+func newRangeHashRequest(typ refs.ChecksumType, salt []byte, rngs ...*object.Range) *object.GetRangeHashRequestBody {
+	req := new(object.GetRangeHashRequestBody)
+	req.SetType(typ)
+	req.SetSalt(salt)
+	req.SetRanges(rngs)
+
+	return req
+}
+
+func TestCalculateRangeHashes(t *testing.T) {
+	payload := []byte("0123456789")
+
+	t.Run("sha256", func(t *testing.T) {
+		hs, err := object.CalculateRangeHashes(payload, []*object.Range{newRange(2, 3), newRange(0, 0)}, nil, refs.SHA256)
+		require.NoError(t, err)
+
+		exp1, exp2 := sha256.Sum256([]byte("234")), sha256.Sum256(nil)
+		require.Equal(t, [][]byte{exp1[:], exp2[:]}, hs)
+	})
+
+	t.Run("tillich-zemor", func(t *testing.T) {
+		hs, err := object.CalculateRangeHashes(payload, []*object.Range{newRange(0, 10)}, nil, refs.TillichZemor)
+		require.NoError(t, err)
+
+		exp := tz.Sum(payload)
+		require.Equal(t, [][]byte{exp[:]}, hs)
+	})
+
+	t.Run("salt", func(t *testing.T) {
+		hs, err := object.CalculateRangeHashes(payload, []*object.Range{newRange(1, 3)}, []byte{1, 2}, refs.SHA256)
+		require.NoError(t, err)
+
+		exp := sha256.Sum256([]byte{'1' ^ 1, '2' ^ 2, '3' ^ 1})
+		require.Equal(t, [][]byte{exp[:]}, hs)
+	})
+
+	t.Run("out of bounds", func(t *testing.T) {
+		for _, rng := range []*object.Range{newRange(11, 0), newRange(5, 6), newRange(1, ^uint64(0))} {
+			_, err := object.CalculateRangeHashes(payload, []*object.Range{rng}, nil, refs.SHA256)
+			require.True(t, errors.Is(err, object.ErrRangeOutOfBounds))
+		}
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := object.CalculateRangeHashes(payload, nil, nil, refs.UnknownChecksum)
+		require.True(t, errors.Is(err, object.ErrUnsupportedChecksum))
+	})
+}
+
+func TestVerifyRangeHashResponse(t *testing.T) {
+	payload := []byte("0123456789")
+	req := newRangeHashRequest(refs.TillichZemor, []byte{7}, newRange(0, 4), newRange(4, 4), newRange(8, 2))
+
+	resp, err := object.CalculateRangeHashResponse(payload, req)
+	require.NoError(t, err)
+	require.NoError(t, object.VerifyRangeHashResponse(payload, req, resp))
+
+	t.Run("mismatched ranges", func(t *testing.T) {
+		other := append([]byte{}, payload...)
+		other[0], other[9] = 'a', 'b'
+
+		err := object.VerifyRangeHashResponse(other, req, resp)
+
+		var mismatch *object.RangeHashMismatchError
+		require.True(t, errors.As(err, &mismatch))
+		require.Equal(t, []int{0, 2}, mismatch.Indices)
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		resp.SetType(refs.SHA256)
+		defer resp.SetType(refs.TillichZemor)
+
+		require.Error(t, object.VerifyRangeHashResponse(payload, req, resp))
+	})
+
+	t.Run("hash number mismatch", func(t *testing.T) {
+		hs := resp.GetHashList()
+		defer resp.SetHashList(hs)
+
+		resp.SetHashList(hs[:2])
+
+		require.Error(t, object.VerifyRangeHashResponse(payload, req, resp))
+	})
+}
@@ -0,0 +1,148 @@
+package object
+
+import (
+	"context"
+	"io"
+
+	object "github.com/cthulhu-rider/neofs-api-go/v2/object/grpc"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+type grpcServer struct {
+	svc Service
+}
+
+// NewGRPCServer returns gRPC server of the object service
+// which handles requests with svc.
+func NewGRPCServer(svc Service) object.ObjectServiceServer {
+	return &grpcServer{
+		svc: svc,
+	}
+}
+
+// RegisterGRPCServer registers gRPC server of the object service
+// which handles requests with svc on gs.
+func RegisterGRPCServer(gs *grpc.Server, svc Service) {
+	object.RegisterObjectServiceServer(gs, NewGRPCServer(svc))
+}
+
+func (s *grpcServer) Get(req *object.GetRequest, srv object.ObjectService_GetServer) error {
+	stream, err := s.svc.Get(srv.Context(), GetRequestFromGRPCMessage(req))
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		if err := srv.Send(GetResponseToGRPCMessage(resp)); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *grpcServer) Put(srv object.ObjectService_PutServer) error {
+	stream, err := s.svc.Put(srv.Context())
+	if err != nil {
+		return err
+	}
+
+	for {
+		req, err := srv.Recv()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				return err
+			}
+
+			resp, err := stream.CloseAndRecv()
+			if err != nil {
+				return err
+			}
+
+			return srv.SendAndClose(PutResponseToGRPCMessage(resp))
+		}
+
+		if err := stream.Send(PutRequestFromGRPCMessage(req)); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *grpcServer) Delete(ctx context.Context, req *object.DeleteRequest) (*object.DeleteResponse, error) {
+	resp, err := s.svc.Delete(ctx, DeleteRequestFromGRPCMessage(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return DeleteResponseToGRPCMessage(resp), nil
+}
+
+func (s *grpcServer) Head(ctx context.Context, req *object.HeadRequest) (*object.HeadResponse, error) {
+	resp, err := s.svc.Head(ctx, HeadRequestFromGRPCMessage(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return HeadResponseToGRPCMessage(resp), nil
+}
+
+func (s *grpcServer) Search(req *object.SearchRequest, srv object.ObjectService_SearchServer) error {
+	stream, err := s.svc.Search(srv.Context(), SearchRequestFromGRPCMessage(req))
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		if err := srv.Send(SearchResponseToGRPCMessage(resp)); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *grpcServer) GetRange(req *object.GetRangeRequest, srv object.ObjectService_GetRangeServer) error {
+	stream, err := s.svc.GetRange(srv.Context(), GetRangeRequestFromGRPCMessage(req))
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		if err := srv.Send(GetRangeResponseToGRPCMessage(resp)); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *grpcServer) GetRangeHash(ctx context.Context, req *object.GetRangeHashRequest) (*object.GetRangeHashResponse, error) {
+	resp, err := s.svc.GetRangeHash(ctx, GetRangeHashRequestFromGRPCMessage(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return GetRangeHashResponseToGRPCMessage(resp), nil
+}
@@ -0,0 +1,155 @@
+package object_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/client"
+	"github.com/cthulhu-rider/neofs-api-go/v2/internal/grpctest"
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type testService struct {
+	object.Service
+
+	chunks [][]byte
+
+	received [][]byte
+}
+
+type testGetStream struct {
+	resps []*object.GetResponse
+}
+
+type testPutStream struct {
+	svc *testService
+}
+
+var errTestHead = errors.New("head is not supported")
+
+func (s *testService) Get(context.Context, *object.GetRequest) (object.GetObjectStreamer, error) {
+	stream := new(testGetStream)
+
+	for i := range s.chunks {
+		chunk := new(object.GetObjectPartChunk)
+		chunk.SetChunk(s.chunks[i])
+
+		body := new(object.GetResponseBody)
+		body.SetObjectPart(chunk)
+
+		resp := new(object.GetResponse)
+		resp.SetBody(body)
+
+		stream.resps = append(stream.resps, resp)
+	}
+
+	return stream, nil
+}
+
+func (s *testGetStream) Recv() (*object.GetResponse, error) {
+	if len(s.resps) == 0 {
+		return nil, io.EOF
+	}
+
+	resp := s.resps[0]
+	s.resps = s.resps[1:]
+
+	return resp, nil
+}
+
+func (s *testService) Put(context.Context) (object.PutObjectStreamer, error) {
+	return &testPutStream{svc: s}, nil
+}
+
+func (s *testPutStream) Send(req *object.PutRequest) error {
+	chunk := req.GetBody().GetObjectPart().(*object.PutObjectPartChunk)
+	s.svc.received = append(s.svc.received, chunk.GetChunk())
+
+	return nil
+}
+
+func (s *testPutStream) CloseAndRecv() (*object.PutResponse, error) {
+	id := new(refs.ObjectID)
+	id.SetValue([]byte{byte(len(s.svc.received))})
+
+	body := new(object.PutResponseBody)
+	body.SetObjectID(id)
+
+	resp := new(object.PutResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
+
+func (s *testService) Head(context.Context, *object.HeadRequest) (*object.HeadResponse, error) {
+	return nil, errTestHead
+}
+
+func TestGRPCServer(t *testing.T) {
+	svc := &testService{
+		chunks: [][]byte{{1, 2}, {3}, {4, 5, 6}},
+	}
+
+	conn := grpctest.Dial(t, func(gs *grpc.Server) {
+		object.RegisterGRPCServer(gs, svc)
+	})
+
+	c, err := object.NewClient(object.WithGlobalOpts(client.WithGRPCConn(conn)))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("get", func(t *testing.T) {
+		stream, err := c.Get(ctx, new(object.GetRequest))
+		require.NoError(t, err)
+
+		var chunks [][]byte
+
+		for {
+			resp, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			require.NoError(t, err)
+
+			chunks = append(chunks, resp.GetBody().GetObjectPart().(*object.GetObjectPartChunk).GetChunk())
+		}
+
+		require.Equal(t, svc.chunks, chunks)
+	})
+
+	t.Run("put", func(t *testing.T) {
+		stream, err := c.Put(ctx)
+		require.NoError(t, err)
+
+		for i := range svc.chunks {
+			chunk := new(object.PutObjectPartChunk)
+			chunk.SetChunk(svc.chunks[i])
+
+			body := new(object.PutRequestBody)
+			body.SetObjectPart(chunk)
+
+			req := new(object.PutRequest)
+			req.SetBody(body)
+
+			require.NoError(t, stream.Send(req))
+		}
+
+		resp, err := stream.CloseAndRecv()
+		require.NoError(t, err)
+		require.Equal(t, []byte{byte(len(svc.chunks))}, resp.GetBody().GetObjectID().GetValue())
+		require.Equal(t, svc.chunks, svc.received)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := c.Head(ctx, new(object.HeadRequest))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), errTestHead.Error())
+	})
+}
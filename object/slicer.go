@@ -0,0 +1,304 @@
+package object
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"hash"
+	"io"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/internal/uuid"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/signature"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/tz"
+	"github.com/pkg/errors"
+)
+
+// Slicer splits the payload of the large object into a chain of
+// smaller objects according to NeoFS split rules.
+//
+// Each object of the chain carries split ID and the identifier of the
+// previous object. The last child object carries the header, identifier
+// and signature of the parent object. The chain is finished with
+// the linking object which lists identifiers of all children.
+//
+// If the payload fits into a single part, only one object is produced.
+type Slicer struct {
+	hdr *Header
+
+	key *ecdsa.PrivateKey
+
+	cfg *slicerCfg
+}
+
+// SlicerOption is a Slicer configuration option.
+type SlicerOption func(*slicerCfg)
+
+type slicerCfg struct {
+	partSize uint64
+
+	splitID []byte
+
+	signOpts []signature.SignOption
+}
+
+// DefaultPartSize is a default maximum payload size of the split chain object.
+//
+// Slicer keeps the whole payload of the current part in memory, so memory
+// consumption of Slice is proportional to the part size, which is 64MB
+// by default.
+const DefaultPartSize = 64 << 20
+
+// ErrEmptyPartSize is returned when zero part size is configured.
+var ErrEmptyPartSize = errors.New("empty part size")
+
+func defaultSlicerCfg() *slicerCfg {
+	return &slicerCfg{
+		partSize: DefaultPartSize,
+	}
+}
+
+// NewSlicer creates Slicer of objects with parent header template hdr.
+//
+// Objects are signed with the key.
+func NewSlicer(hdr *Header, key *ecdsa.PrivateKey, opts ...SlicerOption) *Slicer {
+	cfg := defaultSlicerCfg()
+
+	for i := range opts {
+		opts[i](cfg)
+	}
+
+	return &Slicer{
+		hdr: hdr,
+		key: key,
+		cfg: cfg,
+	}
+}
+
+// WithPartSize returns option to set maximum payload size of the split
+// chain object.
+//
+// The part is buffered in memory until its object is passed to the handler,
+// since the object identifier depends on the payload checksums. Memory
+// consumption of Slice grows with v, see DefaultPartSize.
+func WithPartSize(v uint64) SlicerOption {
+	return func(c *slicerCfg) {
+		c.partSize = v
+	}
+}
+
+// WithSplitID returns option to set split ID of the chain.
+//
+// By default, random UUIDv4 is used.
+func WithSplitID(v []byte) SlicerOption {
+	return func(c *slicerCfg) {
+		c.splitID = v
+	}
+}
+
+// WithSignOptions returns option to set signature options of the object
+// identifiers.
+func WithSignOptions(v ...signature.SignOption) SlicerOption {
+	return func(c *slicerCfg) {
+		c.signOpts = v
+	}
+}
+
+// Slice reads the payload from r and passes resulting objects to the handler
+// in the order they should be stored.
+//
+// Each object owns its payload buffer, so the handler may retain it.
+//
+// Returns identifier of the parent object.
+func (s *Slicer) Slice(r io.Reader, handler func(*Object) error) (*refs.ObjectID, error) {
+	if s.cfg.partSize == 0 {
+		return nil, ErrEmptyPartSize
+	}
+
+	var (
+		br = bufio.NewReader(r)
+
+		payloadLen uint64
+		payloadSum = sha256.New()
+		homoSum    = tz.New()
+
+		splitID  []byte
+		previous *refs.ObjectID
+		children []*refs.ObjectID
+		par      *Object
+	)
+
+	for {
+		part, last, err := s.readPart(br)
+		if err != nil {
+			return nil, err
+		}
+
+		if previous == nil && last {
+			// payload fits into a single object
+			obj := new(Object)
+			obj.SetHeader(s.parentHeader())
+			obj.SetPayload(part)
+
+			if err := s.finalize(obj); err != nil {
+				return nil, err
+			}
+
+			if err := handler(obj); err != nil {
+				return nil, err
+			}
+
+			return obj.GetObjectID(), nil
+		}
+
+		if splitID == nil {
+			if splitID, err = s.splitID(); err != nil {
+				return nil, err
+			}
+		}
+
+		payloadLen += uint64(len(part))
+		_, _ = payloadSum.Write(part)
+		_, _ = homoSum.Write(part)
+
+		split := new(SplitHeader)
+		split.SetSplitID(splitID)
+		split.SetPrevious(previous)
+
+		if last {
+			if par, err = s.parent(payloadLen, payloadSum, homoSum); err != nil {
+				return nil, err
+			}
+
+			setSplitParent(split, par)
+		}
+
+		obj := new(Object)
+		obj.SetHeader(s.childHeader(split))
+		obj.SetPayload(part)
+
+		if err := s.finalize(obj); err != nil {
+			return nil, err
+		}
+
+		if err := handler(obj); err != nil {
+			return nil, err
+		}
+
+		previous = obj.GetObjectID()
+		children = append(children, previous)
+
+		if last {
+			link := new(SplitHeader)
+			link.SetSplitID(splitID)
+			link.SetChildren(children)
+			setSplitParent(link, par)
+
+			obj := new(Object)
+			obj.SetHeader(s.childHeader(link))
+
+			if err := s.finalize(obj); err != nil {
+				return nil, err
+			}
+
+			if err := handler(obj); err != nil {
+				return nil, err
+			}
+
+			return par.GetObjectID(), nil
+		}
+	}
+}
+
+func (s *Slicer) readPart(r *bufio.Reader) ([]byte, bool, error) {
+	part := new(bytes.Buffer)
+
+	_, err := io.CopyN(part, r, int64(s.cfg.partSize))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, false, errors.Wrap(err, "could not read payload")
+	}
+
+	if _, err := r.Peek(1); err != nil {
+		if !errors.Is(err, io.EOF) {
+			return nil, false, errors.Wrap(err, "could not read payload")
+		}
+
+		return part.Bytes(), true, nil
+	}
+
+	return part.Bytes(), false, nil
+}
+
+func (s *Slicer) splitID() ([]byte, error) {
+	if s.cfg.splitID != nil {
+		return s.cfg.splitID, nil
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate split ID")
+	}
+
+	return id, nil
+}
+
+func (s *Slicer) parentHeader() *Header {
+	hdr := new(Header)
+	if s.hdr != nil {
+		*hdr = *s.hdr
+	}
+
+	return hdr
+}
+
+func (s *Slicer) childHeader(split *SplitHeader) *Header {
+	hdr := new(Header)
+	hdr.SetVersion(s.hdr.GetVersion())
+	hdr.SetContainerID(s.hdr.GetContainerID())
+	hdr.SetOwnerID(s.hdr.GetOwnerID())
+	hdr.SetCreationEpoch(s.hdr.GetCreationEpoch())
+	hdr.SetSessionToken(s.hdr.GetSessionToken())
+	hdr.SetObjectType(TypeRegular)
+	hdr.SetSplit(split)
+
+	return hdr
+}
+
+func (s *Slicer) parent(ln uint64, payloadSum, homoSum hash.Hash) (*Object, error) {
+	hdr := s.parentHeader()
+	hdr.SetPayloadLength(ln)
+	hdr.SetPayloadHash(checksum(refs.SHA256, payloadSum))
+	hdr.SetHomomorphicHash(checksum(refs.TillichZemor, homoSum))
+
+	par := new(Object)
+	par.SetHeader(hdr)
+
+	if err := CalculateAndSetID(par); err != nil {
+		return nil, errors.Wrap(err, "could not set parent identifier")
+	}
+
+	if err := CalculateAndSetSignature(s.key, par, s.cfg.signOpts...); err != nil {
+		return nil, errors.Wrap(err, "could not set parent signature")
+	}
+
+	return par, nil
+}
+
+func (s *Slicer) finalize(obj *Object) error {
+	return SetVerificationFields(s.key, obj, s.cfg.signOpts...)
+}
+
+func setSplitParent(split *SplitHeader, par *Object) {
+	split.SetParent(par.GetObjectID())
+	split.SetParentSignature(par.GetSignature())
+	split.SetParentHeader(par.GetHeader())
+}
+
+func checksum(typ refs.ChecksumType, h hash.Hash) *refs.Checksum {
+	cs := new(refs.Checksum)
+	cs.SetType(typ)
+	cs.SetSum(h.Sum(nil))
+
+	return cs
+}
@@ -0,0 +1,112 @@
+package object_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/signature"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func sliceObject(t *testing.T, payload []byte, partSize uint64) ([]*object.Object, *object.Header) {
+	hdr := generateHeader(0)
+
+	s := object.NewSlicer(hdr, test.DecodeKey(0),
+		object.WithPartSize(partSize),
+		object.WithSignOptions(signature.SignWithRFC6979()),
+	)
+
+	var objs []*object.Object
+
+	id, err := s.Slice(bytes.NewReader(payload), func(obj *object.Object) error {
+		require.NoError(t, object.Verify(obj, signature.SignWithRFC6979()))
+
+		objs = append(objs, obj)
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.NotNil(t, id)
+
+	return objs, hdr
+}
+
+func TestSlicer(t *testing.T) {
+	payload := make([]byte, 1000)
+
+	_, err := rand.Read(payload)
+	require.NoError(t, err)
+
+	t.Run("single object", func(t *testing.T) {
+		for _, ln := range []int{0, 1, 100} {
+			objs, hdr := sliceObject(t, payload[:ln], 100)
+			require.Len(t, objs, 1)
+
+			obj := objs[0]
+			require.Equal(t, payload[:ln], obj.GetPayload())
+			require.Nil(t, obj.GetHeader().GetSplit())
+			require.Equal(t, hdr.GetAttributes(), obj.GetHeader().GetAttributes())
+		}
+	})
+
+	t.Run("split chain", func(t *testing.T) {
+		for _, ln := range []int{101, 300, 1000} {
+			objs, _ := sliceObject(t, payload[:ln], 100)
+			require.Len(t, objs, (ln+99)/100+1)
+
+			var (
+				children = objs[:len(objs)-1]
+				link     = objs[len(objs)-1]
+				last     = children[len(children)-1]
+				split    = last.GetHeader().GetSplit()
+				full     []byte
+			)
+
+			for i, child := range children {
+				childSplit := child.GetHeader().GetSplit()
+				require.Equal(t, split.GetSplitID(), childSplit.GetSplitID())
+
+				if i == 0 {
+					require.Nil(t, childSplit.GetPrevious())
+				} else {
+					require.Equal(t, children[i-1].GetObjectID(), childSplit.GetPrevious())
+				}
+
+				if child != last {
+					require.Nil(t, childSplit.GetParentHeader())
+				}
+
+				full = append(full, child.GetPayload()...)
+			}
+
+			require.Equal(t, payload[:ln], full)
+
+			par := new(object.Object)
+			par.SetObjectID(split.GetParent())
+			par.SetSignature(split.GetParentSignature())
+			par.SetHeader(split.GetParentHeader())
+			par.SetPayload(full)
+			require.NoError(t, object.Verify(par, signature.SignWithRFC6979()))
+
+			linkSplit := link.GetHeader().GetSplit()
+			require.Empty(t, link.GetPayload())
+			require.Equal(t, split.GetSplitID(), linkSplit.GetSplitID())
+			require.Equal(t, split.GetParent(), linkSplit.GetParent())
+			require.Len(t, linkSplit.GetChildren(), len(children))
+
+			for i := range children {
+				require.Equal(t, children[i].GetObjectID(), linkSplit.GetChildren()[i])
+			}
+		}
+	})
+
+	t.Run("zero part size", func(t *testing.T) {
+		s := object.NewSlicer(generateHeader(0), test.DecodeKey(0), object.WithPartSize(0))
+
+		_, err := s.Slice(bytes.NewReader(payload), func(*object.Object) error { return nil })
+		require.Equal(t, object.ErrEmptyPartSize, err)
+	})
+}
@@ -0,0 +1,396 @@
+package stream
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/pkg/errors"
+)
+
+// Assembler restores objects which were split into a chain of child
+// objects according to NeoFS split rules.
+//
+// The chain is resolved through the linking object. If the linking object
+// is unreachable, the chain is restored backwards starting from the last
+// child object.
+type Assembler struct {
+	svc object.Service
+
+	cfg *cfg
+}
+
+// ErrIncompleteChain is returned when some objects of the split chain
+// cannot be reached.
+var ErrIncompleteChain = errors.New("incomplete split chain")
+
+// ErrInconsistentChain is returned when objects of the split chain
+// do not refer to each other or to the parent object correctly.
+var ErrInconsistentChain = errors.New("inconsistent split chain")
+
+type chain struct {
+	cid *refs.ContainerID
+
+	// parent object without payload
+	parent *object.Object
+
+	splitID []byte
+
+	// nil for the object which was not split
+	children []*refs.ObjectID
+}
+
+// NewAssembler creates Assembler of the objects stored in svc.
+func NewAssembler(svc object.Service, opts ...Option) *Assembler {
+	return &Assembler{
+		svc: svc,
+		cfg: newCfg(opts...),
+	}
+}
+
+// Chain resolves the object by address and returns the object without
+// payload together with the ordered list of its child objects.
+//
+// If the object was not split, the list is empty.
+func (a *Assembler) Chain(ctx context.Context, addr *refs.Address) (*object.Object, []*refs.ObjectID, error) {
+	c, err := a.resolve(ctx, addr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return c.parent, c.children, nil
+}
+
+// Assemble resolves the object by address and returns the object without
+// payload and the reader of the full object payload.
+//
+// Payload of the split object is read from the child objects in order.
+// Each child object is checked to belong to the chain of the parent object.
+// If some child object cannot be reached, reader returns ErrIncompleteChain.
+// If the chain is broken, reader returns ErrInconsistentChain.
+//
+// Reader must be closed after use.
+func (a *Assembler) Assemble(ctx context.Context, addr *refs.Address) (*object.Object, io.ReadCloser, error) {
+	c, err := a.resolve(ctx, addr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	return c.parent, &payloadReader{
+		ctx:    ctx,
+		cancel: cancel,
+		a:      a,
+		chain:  c,
+	}, nil
+}
+
+func (a *Assembler) resolve(ctx context.Context, addr *refs.Address) (*chain, error) {
+	part, err := a.cfg.head(ctx, a.svc, addr, true)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := part.(type) {
+	case *object.HeaderWithSignature:
+		obj := new(object.Object)
+		obj.SetObjectID(addr.GetObjectID())
+		obj.SetSignature(v.GetSignature())
+		obj.SetHeader(v.GetHeader())
+
+		return &chain{
+			cid:    addr.GetContainerID(),
+			parent: obj,
+		}, nil
+	case *object.SplitInfo:
+		return a.resolveSplit(ctx, addr, v)
+	default:
+		return nil, errors.Errorf("unexpected header part %T", v)
+	}
+}
+
+func (a *Assembler) resolveSplit(ctx context.Context, addr *refs.Address, info *object.SplitInfo) (*chain, error) {
+	var errLink, errLast error
+
+	if link := info.GetLink(); link != nil {
+		c, err := a.resolveLink(ctx, addr, link, info.GetSplitID())
+		if err == nil {
+			return c, nil
+		}
+
+		if errors.Is(err, ErrInconsistentChain) {
+			return nil, err
+		}
+
+		errLink = err
+	}
+
+	if last := info.GetLastPart(); last != nil {
+		c, err := a.resolveLast(ctx, addr, last, info.GetSplitID())
+		if err == nil {
+			return c, nil
+		}
+
+		if errors.Is(err, ErrInconsistentChain) {
+			return nil, err
+		}
+
+		errLast = err
+	}
+
+	switch {
+	case errLast != nil:
+		return nil, errors.Wrapf(ErrIncompleteChain, "could not resolve chain by last part: %v", errLast)
+	case errLink != nil:
+		return nil, errors.Wrapf(ErrIncompleteChain, "could not resolve chain by link: %v", errLink)
+	default:
+		return nil, errors.Wrap(ErrIncompleteChain, "empty split info")
+	}
+}
+
+func (a *Assembler) headChild(ctx context.Context, cid *refs.ContainerID, id *refs.ObjectID) (*object.Header, error) {
+	part, err := a.cfg.head(ctx, a.svc, address(cid, id), true)
+	if err != nil {
+		return nil, err
+	}
+
+	v, ok := part.(*object.HeaderWithSignature)
+	if !ok {
+		return nil, errors.Wrapf(ErrInconsistentChain, "unexpected header part %T of child object", part)
+	}
+
+	if v.GetHeader().GetSplit() == nil {
+		return nil, errors.Wrap(ErrInconsistentChain, "child object without split header")
+	}
+
+	return v.GetHeader(), nil
+}
+
+func (a *Assembler) resolveLink(ctx context.Context, addr *refs.Address, link *refs.ObjectID, splitID []byte) (*chain, error) {
+	hdr, err := a.headChild(ctx, addr.GetContainerID(), link)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get linking object")
+	}
+
+	split := hdr.GetSplit()
+
+	if err := checkSplitParent(split, addr.GetObjectID(), splitID); err != nil {
+		return nil, err
+	}
+
+	if len(split.GetChildren()) == 0 {
+		return nil, errors.Wrap(ErrInconsistentChain, "empty children list in linking object")
+	}
+
+	return newChain(addr, split), nil
+}
+
+func (a *Assembler) resolveLast(ctx context.Context, addr *refs.Address, last *refs.ObjectID, splitID []byte) (*chain, error) {
+	hdr, err := a.headChild(ctx, addr.GetContainerID(), last)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get last child object")
+	}
+
+	split := hdr.GetSplit()
+
+	if err := checkSplitParent(split, addr.GetObjectID(), splitID); err != nil {
+		return nil, err
+	}
+
+	c := newChain(addr, split)
+	c.children = []*refs.ObjectID{last}
+
+	visited := map[string]struct{}{
+		string(last.GetValue()): {},
+	}
+
+	for prev := split.GetPrevious(); prev != nil; {
+		if _, ok := visited[string(prev.GetValue())]; ok {
+			return nil, errors.Wrap(ErrInconsistentChain, "cyclic reference to the previous object")
+		}
+
+		visited[string(prev.GetValue())] = struct{}{}
+
+		hdr, err := a.headChild(ctx, addr.GetContainerID(), prev)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get child object #%d from the end", len(c.children))
+		}
+
+		child := hdr.GetSplit()
+
+		if !bytes.Equal(child.GetSplitID(), c.splitID) {
+			return nil, errors.Wrap(ErrInconsistentChain, "split ID mismatch")
+		}
+
+		c.children = append(c.children, prev)
+		prev = child.GetPrevious()
+	}
+
+	for i, j := 0, len(c.children)-1; i < j; i, j = i+1, j-1 {
+		c.children[i], c.children[j] = c.children[j], c.children[i]
+	}
+
+	return c, nil
+}
+
+func newChain(addr *refs.Address, split *object.SplitHeader) *chain {
+	par := new(object.Object)
+	par.SetObjectID(split.GetParent())
+	par.SetSignature(split.GetParentSignature())
+	par.SetHeader(split.GetParentHeader())
+
+	return &chain{
+		cid:      addr.GetContainerID(),
+		parent:   par,
+		splitID:  split.GetSplitID(),
+		children: split.GetChildren(),
+	}
+}
+
+func checkSplitParent(split *object.SplitHeader, parent *refs.ObjectID, splitID []byte) error {
+	switch {
+	case !equalIDs(split.GetParent(), parent):
+		return errors.Wrap(ErrInconsistentChain, "parent ID mismatch")
+	case split.GetParentHeader() == nil:
+		return errors.Wrap(ErrInconsistentChain, "missing parent header")
+	case splitID != nil && !bytes.Equal(split.GetSplitID(), splitID):
+		return errors.Wrap(ErrInconsistentChain, "split ID mismatch")
+	}
+
+	return nil
+}
+
+func equalIDs(a, b *refs.ObjectID) bool {
+	return bytes.Equal(a.GetValue(), b.GetValue())
+}
+
+type payloadReader struct {
+	ctx context.Context
+
+	cancel context.CancelFunc
+
+	a *Assembler
+
+	*chain
+
+	// index of the current child object
+	i int
+
+	cur io.ReadCloser
+
+	read uint64
+
+	err error
+}
+
+func (r *payloadReader) Read(p []byte) (int, error) {
+	n := 0
+
+	for n < len(p) && r.err == nil {
+		if r.cur == nil {
+			r.err = r.next()
+			continue
+		}
+
+		k, err := r.cur.Read(p[n:])
+		n += k
+		r.read += uint64(k)
+
+		if err != nil {
+			_ = r.cur.Close()
+			r.cur = nil
+
+			if !errors.Is(err, io.EOF) {
+				r.err = errors.Wrapf(err, "could not read payload of child object #%d", r.i)
+			}
+
+			r.i++
+		}
+	}
+
+	if n > 0 {
+		return n, nil
+	}
+
+	return 0, r.err
+}
+
+func (r *payloadReader) Close() error {
+	r.cancel()
+
+	if r.cur != nil {
+		_ = r.cur.Close()
+		r.cur = nil
+	}
+
+	if r.err == nil {
+		r.err = errors.New("reader is closed")
+	}
+
+	return nil
+}
+
+func (r *payloadReader) split() bool {
+	return r.children != nil
+}
+
+// next opens the reader of the next object of the chain.
+func (r *payloadReader) next() error {
+	if r.split() && r.i == len(r.children) || !r.split() && r.i > 0 {
+		if r.read != r.parent.GetHeader().GetPayloadLength() {
+			return errors.Wrap(ErrInconsistentChain, "payload length mismatch")
+		}
+
+		return io.EOF
+	}
+
+	id := r.parent.GetObjectID()
+	if r.split() {
+		id = r.children[r.i]
+	}
+
+	init, cur, err := r.a.cfg.getPayload(r.ctx, r.a.svc, address(r.cid, id), r.split())
+	if err != nil {
+		if !r.split() {
+			return errors.Wrap(err, "could not get object")
+		}
+
+		return errors.Wrapf(ErrIncompleteChain, "could not get child object #%d: %v", r.i, err)
+	}
+
+	if r.split() {
+		if err := r.checkChild(init); err != nil {
+			_ = cur.Close()
+			return errors.Wrapf(err, "child object #%d", r.i)
+		}
+	}
+
+	r.cur = cur
+
+	return nil
+}
+
+func (r *payloadReader) checkChild(init *object.GetObjectPartInit) error {
+	split := init.GetHeader().GetSplit()
+
+	var prev *refs.ObjectID
+	if r.i > 0 {
+		prev = r.children[r.i-1]
+	}
+
+	switch {
+	case !equalIDs(init.GetObjectID(), r.children[r.i]):
+		return errors.Wrap(ErrInconsistentChain, "object ID mismatch")
+	case !bytes.Equal(split.GetSplitID(), r.splitID):
+		return errors.Wrap(ErrInconsistentChain, "split ID mismatch")
+	case !equalIDs(split.GetPrevious(), prev):
+		return errors.Wrap(ErrInconsistentChain, "previous object ID mismatch")
+	case r.i == len(r.children)-1 && !equalIDs(split.GetParent(), r.parent.GetObjectID()):
+		return errors.Wrap(ErrInconsistentChain, "parent ID mismatch")
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package stream_test
+
+import (
+	"context"
+	"crypto/rand"
+	"io/ioutil"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/object/stream"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/signature"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func assemble(t *testing.T, svc *testService, a *stream.Assembler, payload []byte, partSize uint64) ([]*object.Object, error) {
+	addr, objs := svc.slice(t, payload, partSize)
+
+	obj, r, err := a.Assemble(context.Background(), addr)
+	if err != nil {
+		return objs, err
+	}
+
+	defer r.Close()
+
+	full, err := ioutil.ReadAll(r)
+	if err != nil {
+		return objs, err
+	}
+
+	obj.SetPayload(full)
+
+	require.Equal(t, addr.GetObjectID(), obj.GetObjectID())
+	require.Equal(t, payload, full)
+	require.NoError(t, object.Verify(obj, signature.SignWithRFC6979()))
+
+	return objs, nil
+}
+
+func TestAssembler(t *testing.T) {
+	payload := make([]byte, 1000)
+
+	_, err := rand.Read(payload)
+	require.NoError(t, err)
+
+	t.Run("single object", func(t *testing.T) {
+		svc := newTestService()
+		a := stream.NewAssembler(svc)
+
+		objs, err := assemble(t, svc, a, payload[:50], 100)
+		require.NoError(t, err)
+		require.Len(t, objs, 1)
+
+		obj, children, err := a.Chain(context.Background(), addressOf(objs[0]))
+		require.NoError(t, err)
+		require.Empty(t, children)
+		require.Equal(t, objs[0].GetHeader(), obj.GetHeader())
+	})
+
+	t.Run("split chain", func(t *testing.T) {
+		svc := newTestService()
+		a := stream.NewAssembler(svc)
+
+		objs, err := assemble(t, svc, a, payload, 100)
+		require.NoError(t, err)
+
+		_, children, err := a.Chain(context.Background(), parentAddress(objs))
+		require.NoError(t, err)
+		require.Len(t, children, len(objs)-1)
+	})
+
+	t.Run("without link", func(t *testing.T) {
+		svc := newTestService()
+		a := stream.NewAssembler(svc)
+
+		addr, objs := svc.slice(t, payload, 100)
+		delete(svc.objects, key(objs[len(objs)-1].GetObjectID()))
+
+		_, children, err := a.Chain(context.Background(), addr)
+		require.NoError(t, err)
+		require.Len(t, children, len(objs)-1)
+
+		for i := range children {
+			require.Equal(t, objs[i].GetObjectID(), children[i])
+		}
+	})
+
+	t.Run("cyclic chain", func(t *testing.T) {
+		svc := newTestService()
+		a := stream.NewAssembler(svc)
+
+		addr, objs := svc.slice(t, payload, 100)
+		delete(svc.objects, key(objs[len(objs)-1].GetObjectID()))
+
+		objs[0].GetHeader().GetSplit().SetPrevious(objs[2].GetObjectID())
+
+		_, _, err := a.Chain(context.Background(), addr)
+		require.True(t, errors.Is(err, stream.ErrInconsistentChain), err)
+	})
+
+	t.Run("missing child", func(t *testing.T) {
+		svc := newTestService()
+		a := stream.NewAssembler(svc)
+
+		addr, objs := svc.slice(t, payload, 100)
+		delete(svc.objects, key(objs[3].GetObjectID()))
+
+		_, r, err := a.Assemble(context.Background(), addr)
+		require.NoError(t, err)
+
+		defer r.Close()
+
+		_, err = ioutil.ReadAll(r)
+		require.True(t, errors.Is(err, stream.ErrIncompleteChain))
+
+		// without link the chain cannot be restored at all
+		delete(svc.objects, key(objs[len(objs)-1].GetObjectID()))
+
+		_, _, err = a.Chain(context.Background(), addr)
+		require.True(t, errors.Is(err, stream.ErrIncompleteChain))
+	})
+
+	t.Run("foreign child", func(t *testing.T) {
+		svc := newTestService()
+		a := stream.NewAssembler(svc)
+
+		addr, objs := svc.slice(t, payload, 100)
+		_, other := newTestService().slice(t, payload, 100)
+
+		svc.objects[key(objs[2].GetObjectID())] = other[2]
+
+		_, r, err := a.Assemble(context.Background(), addr)
+		require.NoError(t, err)
+
+		defer r.Close()
+
+		_, err = ioutil.ReadAll(r)
+		require.True(t, errors.Is(err, stream.ErrInconsistentChain))
+	})
+}
+
+func addressOf(obj *object.Object) *refs.Address {
+	addr := new(refs.Address)
+	addr.SetContainerID(obj.GetHeader().GetContainerID())
+	addr.SetObjectID(obj.GetObjectID())
+
+	return addr
+}
+
+func parentAddress(objs []*object.Object) *refs.Address {
+	split := objs[len(objs)-1].GetHeader().GetSplit()
+
+	addr := new(refs.Address)
+	addr.SetContainerID(split.GetParentHeader().GetContainerID())
+	addr.SetObjectID(split.GetParent())
+
+	return addr
+}
@@ -0,0 +1,140 @@
+package stream
+
+import (
+	"context"
+	"io"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/pkg/errors"
+)
+
+// SplitInfoError is returned when the requested object is split
+// and the server responded with the split information.
+type SplitInfoError struct {
+	info *object.SplitInfo
+}
+
+func (e *SplitInfoError) Error() string {
+	return "object is split"
+}
+
+// SplitInfo returns split information of the requested object.
+func (e *SplitInfoError) SplitInfo() *object.SplitInfo {
+	return e.info
+}
+
+type payloadStream struct {
+	cancel context.CancelFunc
+
+	stream object.GetObjectStreamer
+
+	buf []byte
+
+	err error
+}
+
+// Get requests the object by address and returns its initial part and
+// the reader of the object payload.
+//
+// If the server responds with the split information, *SplitInfoError
+// is returned. If payload verification is enabled, reader fails with
+// *IntegrityError when received payload does not match the object header.
+//
+// Reader must be closed after use.
+func Get(ctx context.Context, svc object.Service, addr *refs.Address, opts ...Option) (*object.GetObjectPartInit, io.ReadCloser, error) {
+	c := newCfg(opts...)
+
+	return c.getPayload(ctx, svc, addr, c.raw)
+}
+
+func (c *cfg) getPayload(ctx context.Context, svc object.Service, addr *refs.Address, raw bool) (*object.GetObjectPartInit, io.ReadCloser, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := c.get(ctx, svc, addr, raw)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		cancel()
+		return nil, nil, errors.Wrap(err, "could not receive initial part")
+	}
+
+	switch v := resp.GetBody().GetObjectPart().(type) {
+	case *object.GetObjectPartInit:
+		var r io.ReadCloser = &payloadStream{
+			cancel: cancel,
+			stream: stream,
+		}
+
+		if c.verify {
+			if r, err = newVerifyingReader(r, v.GetHeader()); err != nil {
+				cancel()
+				return nil, nil, err
+			}
+		}
+
+		return v, r, nil
+	case *object.SplitInfo:
+		cancel()
+		return nil, nil, &SplitInfoError{info: v}
+	default:
+		cancel()
+		return nil, nil, errors.Errorf("unexpected object part %T instead of init", v)
+	}
+}
+
+func (s *payloadStream) Read(p []byte) (int, error) {
+	n := 0
+
+	for n < len(p) && s.err == nil {
+		if len(s.buf) > 0 {
+			k := copy(p[n:], s.buf)
+			s.buf = s.buf[k:]
+			n += k
+
+			continue
+		}
+
+		s.err = s.next()
+	}
+
+	if n > 0 {
+		return n, nil
+	}
+
+	return 0, s.err
+}
+
+func (s *payloadStream) next() error {
+	resp, err := s.stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.EOF
+		}
+
+		return errors.Wrap(err, "could not receive payload chunk")
+	}
+
+	chunk, ok := resp.GetBody().GetObjectPart().(*object.GetObjectPartChunk)
+	if !ok {
+		return errors.Errorf("unexpected object part %T instead of chunk", resp.GetBody().GetObjectPart())
+	}
+
+	s.buf = chunk.GetChunk()
+
+	return nil
+}
+
+func (s *payloadStream) Close() error {
+	s.cancel()
+
+	if s.err == nil {
+		s.err = errors.New("reader is closed")
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package stream_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/object/stream"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/signature"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPutGet(t *testing.T) {
+	svc := newTestService()
+
+	payload := make([]byte, 100)
+
+	_, err := rand.Read(payload)
+	require.NoError(t, err)
+
+	obj := new(object.Object)
+	obj.SetHeader(testHeader())
+	obj.SetPayload(payload)
+	require.NoError(t, object.SetVerificationFields(test.DecodeKey(0), obj, signature.SignWithRFC6979()))
+
+	init := new(object.PutObjectPartInit)
+	init.SetObjectID(obj.GetObjectID())
+	init.SetSignature(obj.GetSignature())
+	init.SetHeader(obj.GetHeader())
+
+	w, err := stream.Put(context.Background(), svc, init,
+		stream.WithChunkSize(uint32(svc.chunkSize)),
+	)
+	require.NoError(t, err)
+
+	_, err = io.Copy(w, bytes.NewReader(payload))
+	require.NoError(t, err)
+	require.Nil(t, w.ObjectID())
+
+	require.NoError(t, w.Close())
+	require.Equal(t, obj.GetObjectID(), w.ObjectID())
+	require.Error(t, svc.putCtx.Err())
+
+	_, err = w.Write([]byte{1})
+	require.Equal(t, stream.ErrWriterClosed, err)
+
+	getInit, r, err := stream.Get(context.Background(), svc, addressOf(obj))
+	require.NoError(t, err)
+
+	defer r.Close()
+
+	require.Equal(t, obj.GetObjectID(), getInit.GetObjectID())
+	require.Equal(t, obj.GetHeader(), getInit.GetHeader())
+
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, payload, data)
+
+	t.Run("failed init", func(t *testing.T) {
+		svc := newTestService()
+		svc.putErr = errors.New("any error")
+
+		_, err := stream.Put(context.Background(), svc, init)
+		require.True(t, errors.Is(err, svc.putErr))
+		require.Error(t, svc.putCtx.Err())
+	})
+
+	t.Run("failed write", func(t *testing.T) {
+		svc := newTestService()
+
+		w, err := stream.Put(context.Background(), svc, init,
+			stream.WithChunkSize(uint32(svc.chunkSize+1)),
+		)
+		require.NoError(t, err)
+		require.NoError(t, svc.putCtx.Err())
+
+		n, err := w.Write(payload)
+		require.Error(t, err)
+		require.Zero(t, n)
+		require.Error(t, svc.putCtx.Err())
+		require.Equal(t, err, w.Close())
+	})
+
+	t.Run("failed close", func(t *testing.T) {
+		svc := newTestService()
+
+		w, err := stream.Put(context.Background(), svc, init,
+			stream.WithChunkSize(uint32(svc.chunkSize+1)),
+		)
+		require.NoError(t, err)
+
+		_, err = w.Write(payload[:svc.chunkSize])
+		require.NoError(t, err)
+
+		svc.putErr = errors.New("any error")
+
+		require.True(t, errors.Is(w.Close(), svc.putErr))
+		require.Error(t, svc.putCtx.Err())
+	})
+
+	t.Run("split info", func(t *testing.T) {
+		addr, _ := svc.slice(t, payload[:50], 10)
+
+		_, _, err := stream.Get(context.Background(), svc, addr, stream.WithRaw(true))
+
+		var e *stream.SplitInfoError
+		require.True(t, errors.As(err, &e))
+		require.NotNil(t, e.SplitInfo().GetLink())
+	})
+}
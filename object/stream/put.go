@@ -0,0 +1,185 @@
+package stream
+
+import (
+	"context"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/pkg/errors"
+)
+
+// Writer sends the object payload to the Put stream in chunks.
+//
+// Writer implements io.WriteCloser. Identifier of the stored object
+// is available after Close.
+type Writer struct {
+	cfg *cfg
+
+	stream object.PutObjectStreamer
+
+	// cancels the stream context and releases the stream
+	cancel context.CancelFunc
+
+	buf []byte
+
+	id *refs.ObjectID
+
+	err error
+}
+
+// DefaultChunkSize is a default maximum size of the payload chunk
+// in the Put request.
+const DefaultChunkSize = 3 << 20
+
+// ErrWriterClosed is returned on writing to the closed Writer.
+var ErrWriterClosed = errors.New("writer is closed")
+
+// WithChunkSize returns option to set maximum size of the payload chunk
+// in the Put request.
+//
+// Zero size is replaced with DefaultChunkSize.
+func WithChunkSize(v uint32) Option {
+	return func(c *cfg) {
+		c.chunkSize = v
+	}
+}
+
+// Put opens the Put stream and sends the initial part of the object.
+//
+// Payload should be written to the returned Writer which must be closed
+// in order to finish the stream. Stream is canceled on any failure.
+func Put(ctx context.Context, svc object.Service, init *object.PutObjectPartInit, opts ...Option) (*Writer, error) {
+	c := newCfg(opts...)
+	if c.chunkSize == 0 {
+		c.chunkSize = DefaultChunkSize
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := svc.Put(ctx)
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not open put stream")
+	}
+
+	w := &Writer{
+		cfg:    c,
+		stream: stream,
+		cancel: cancel,
+		buf:    make([]byte, 0, c.chunkSize),
+	}
+
+	if err := w.send(init); err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not send initial part")
+	}
+
+	return w, nil
+}
+
+func (w *Writer) send(part object.PutObjectPart) error {
+	body := new(object.PutRequestBody)
+	body.SetObjectPart(part)
+
+	req := new(object.PutRequest)
+	req.SetBody(body)
+
+	if err := w.cfg.prepare(req); err != nil {
+		return err
+	}
+
+	return w.stream.Send(req)
+}
+
+func (w *Writer) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	chunk := new(object.PutObjectPartChunk)
+	chunk.SetChunk(w.buf)
+
+	if err := w.send(chunk); err != nil {
+		return errors.Wrap(err, "could not send payload chunk")
+	}
+
+	// sent chunk may be retained by the stream
+	w.buf = make([]byte, 0, w.cfg.chunkSize)
+
+	return nil
+}
+
+// Write buffers p and sends full chunks of payload to the stream.
+//
+// If a chunk is not sent, bytes of p from this chunk are not counted
+// in the returned number.
+func (w *Writer) Write(p []byte) (int, error) {
+	n := 0
+
+	for w.err == nil && len(p) > 0 {
+		k := cap(w.buf) - len(w.buf)
+		if k > len(p) {
+			k = len(p)
+		}
+
+		w.buf = append(w.buf, p[:k]...)
+		p = p[k:]
+
+		if len(w.buf) == cap(w.buf) {
+			if err := w.flush(); err != nil {
+				w.fail(err)
+				break
+			}
+		}
+
+		n += k
+	}
+
+	if w.err != nil {
+		return n, w.err
+	}
+
+	return n, nil
+}
+
+// fail cancels the stream and makes all subsequent operations return err.
+func (w *Writer) fail(err error) {
+	w.err = err
+	w.cancel()
+}
+
+// Close sends the rest of the payload, closes the stream and receives
+// identifier of the stored object.
+//
+// If the stream failed earlier, Close returns the failure.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+
+	if err := w.flush(); err != nil {
+		w.fail(err)
+		return err
+	}
+
+	resp, err := w.stream.CloseAndRecv()
+
+	w.fail(ErrWriterClosed)
+
+	if err != nil {
+		return errors.Wrap(err, "could not close put stream")
+	}
+
+	w.id = resp.GetBody().GetObjectID()
+
+	return nil
+}
+
+// ObjectID returns identifier of the stored object.
+//
+// Close has the signature of io.Closer, so the identifier received on
+// Close is returned by ObjectID. Returns nil if Writer was not closed
+// successfully.
+func (w *Writer) ObjectID() *refs.ObjectID {
+	return w.id
+}
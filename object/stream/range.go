@@ -0,0 +1,359 @@
+package stream
+
+import (
+	"context"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/pkg/errors"
+)
+
+// RangeReader provides random access to the object payload through
+// GetRange requests.
+//
+// RangeReader implements io.ReaderAt, io.ReadSeeker and io.WriterTo.
+// Fetched payload windows are cached, so sequential reads of small portions
+// do not lead to a request per read if read-ahead is configured.
+//
+// Payload of the split object is read from the child objects. Payload
+// lengths of the children are requested on first access, only up to
+// the child containing the requested offset.
+//
+// Only ReadAt is safe for concurrent use. Read, Seek and WriteTo use the
+// current position and must not be called concurrently.
+type RangeReader struct {
+	ctx context.Context
+
+	a *Assembler
+
+	cid *refs.ContainerID
+
+	size uint64
+
+	// objects which contain the payload, single object if it is not split
+	objs []*refs.ObjectID
+
+	raw bool
+
+	readAhead uint64
+
+	// current position of Read and Seek
+	pos int64
+
+	// protects ends, winOff and win
+	mtx sync.Mutex
+
+	// end offsets of the first len(ends) objects in the full payload
+	ends []uint64
+
+	winOff uint64
+
+	win []byte
+}
+
+// ErrNegativeOffset is returned when negative offset is requested.
+var ErrNegativeOffset = errors.New("negative offset")
+
+const writeToBufferSize = 64 << 10
+
+// WithReadAhead returns option to fetch at least v bytes of payload
+// in a single GetRange request.
+//
+// By default, only requested bytes are fetched.
+func WithReadAhead(v uint64) Option {
+	return func(c *cfg) {
+		c.readAhead = v
+	}
+}
+
+// NewRangeReader resolves the object by address and returns RangeReader
+// of its payload.
+//
+// Context is used for all requests of the RangeReader.
+func NewRangeReader(ctx context.Context, svc object.Service, addr *refs.Address, opts ...Option) (*RangeReader, error) {
+	a := NewAssembler(svc, opts...)
+
+	c, err := a.resolve(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &RangeReader{
+		ctx:       ctx,
+		a:         a,
+		cid:       addr.GetContainerID(),
+		size:      c.parent.GetHeader().GetPayloadLength(),
+		readAhead: a.cfg.readAhead,
+	}
+
+	if c.children == nil {
+		r.objs = []*refs.ObjectID{addr.GetObjectID()}
+		r.ends = []uint64{r.size}
+
+		return r, nil
+	}
+
+	r.raw = true
+	r.objs = c.children
+
+	return r, nil
+}
+
+// Size returns full length of the object payload.
+func (r *RangeReader) Size() int64 {
+	return int64(r.size)
+}
+
+// ReadAt reads len(p) bytes of payload starting at off.
+//
+// ReadAt is safe for concurrent use.
+func (r *RangeReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, ErrNegativeOffset
+	}
+
+	n := 0
+
+	for n < len(p) {
+		cur := uint64(off) + uint64(n)
+		if cur >= r.size {
+			return n, io.EOF
+		}
+
+		win, winOff := r.window(cur)
+		if win == nil {
+			var err error
+
+			if win, err = r.fetch(cur, uint64(len(p)-n)); err != nil {
+				return n, err
+			}
+
+			winOff = cur
+		}
+
+		n += copy(p[n:], win[cur-winOff:])
+	}
+
+	return n, nil
+}
+
+// window returns cached payload window containing off or nil.
+func (r *RangeReader) window(off uint64) ([]byte, uint64) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if off < r.winOff || off >= r.winOff+uint64(len(r.win)) {
+		return nil, 0
+	}
+
+	return r.win, r.winOff
+}
+
+// Read reads payload from the current position.
+//
+// Read, Seek and WriteTo share the current position and are not safe
+// for concurrent use, unlike ReadAt.
+func (r *RangeReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	n, err := r.ReadAt(p, r.pos)
+	r.pos += int64(n)
+
+	if n > 0 && errors.Is(err, io.EOF) {
+		err = nil
+	}
+
+	return n, err
+}
+
+// Seek sets the position of the next Read according to io.Seeker.
+//
+// Seek is not safe for concurrent use, see Read.
+func (r *RangeReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.pos
+	case io.SeekEnd:
+		offset += int64(r.size)
+	default:
+		return 0, errors.Errorf("invalid whence %d", whence)
+	}
+
+	if offset < 0 {
+		return 0, ErrNegativeOffset
+	}
+
+	r.pos = offset
+
+	return offset, nil
+}
+
+// WriteTo writes payload from the current position to w.
+//
+// WriteTo is not safe for concurrent use, see Read.
+func (r *RangeReader) WriteTo(w io.Writer) (int64, error) {
+	bufSize := uint64(writeToBufferSize)
+	if r.readAhead > bufSize {
+		bufSize = r.readAhead
+	}
+
+	buf := make([]byte, bufSize)
+
+	var written int64
+
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			k, errW := w.Write(buf[:n])
+			written += int64(k)
+
+			if errW != nil {
+				return written, errW
+			}
+		}
+
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return written, nil
+			}
+
+			return written, err
+		}
+	}
+}
+
+// fetch requests the window of at least ln bytes starting at off and
+// caches it.
+//
+// Window does not cross the boundary of the object containing off.
+func (r *RangeReader) fetch(off, ln uint64) ([]byte, error) {
+	if ln < r.readAhead {
+		ln = r.readAhead
+	}
+
+	i, start, end, err := r.locate(off)
+	if err != nil {
+		return nil, err
+	}
+
+	if ln > end-off {
+		ln = end - off
+	}
+
+	data, err := r.getRange(r.objs[i], off-start, ln)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mtx.Lock()
+	r.winOff = off
+	r.win = data
+	r.mtx.Unlock()
+
+	return data, nil
+}
+
+// locate returns index of the object containing off and the bounds of its
+// payload in the full payload. Payload lengths of the child objects are
+// requested until the object is found.
+func (r *RangeReader) locate(off uint64) (int, uint64, uint64, error) {
+	for {
+		r.mtx.Lock()
+		i := sort.Search(len(r.ends), func(i int) bool {
+			return r.ends[i] > off
+		})
+		n := len(r.ends)
+
+		var start uint64
+		if i > 0 {
+			start = r.ends[i-1]
+		}
+
+		var end uint64
+		if i < n {
+			end = r.ends[i]
+		}
+		r.mtx.Unlock()
+
+		if i < n {
+			return i, start, end, nil
+		}
+
+		if n == len(r.objs) {
+			return 0, 0, 0, errors.Wrap(ErrInconsistentChain, "payload length mismatch")
+		}
+
+		hdr, err := r.a.headChild(r.ctx, r.cid, r.objs[n])
+		if err != nil {
+			return 0, 0, 0, errors.Wrapf(err, "could not get header of child object #%d", n)
+		}
+
+		end = start + hdr.GetPayloadLength()
+		if end > r.size || n == len(r.objs)-1 && end != r.size {
+			return 0, 0, 0, errors.Wrap(ErrInconsistentChain, "payload length mismatch")
+		}
+
+		r.mtx.Lock()
+		if len(r.ends) == n {
+			r.ends = append(r.ends, end)
+		}
+		r.mtx.Unlock()
+	}
+}
+
+func (r *RangeReader) getRange(id *refs.ObjectID, off, ln uint64) ([]byte, error) {
+	rng := new(object.Range)
+	rng.SetOffset(off)
+	rng.SetLength(ln)
+
+	body := new(object.GetRangeRequestBody)
+	body.SetAddress(address(r.cid, id))
+	body.SetRange(rng)
+	body.SetRaw(r.raw)
+
+	req := new(object.GetRangeRequest)
+	req.SetBody(body)
+
+	if err := r.a.cfg.prepare(req); err != nil {
+		return nil, err
+	}
+
+	stream, err := r.a.svc.GetRange(r.ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not send get range request")
+	}
+
+	data := make([]byte, 0, ln)
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, errors.Wrap(err, "could not receive range chunk")
+		}
+
+		switch v := resp.GetBody().GetRangePart().(type) {
+		case *object.GetRangePartChunk:
+			data = append(data, v.GetChunk()...)
+		case *object.SplitInfo:
+			return nil, &SplitInfoError{info: v}
+		default:
+			return nil, errors.Errorf("unexpected range part %T", v)
+		}
+	}
+
+	if uint64(len(data)) != ln {
+		return nil, errors.Errorf("incorrect range length: expected %d, received %d", ln, len(data))
+	}
+
+	return data, nil
+}
@@ -0,0 +1,146 @@
+package stream_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object/stream"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeReader(t *testing.T) {
+	payload := make([]byte, 1000)
+
+	_, err := rand.Read(payload)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name     string
+		partSize uint64
+	}{
+		{name: "single object", partSize: 2000},
+		{name: "split chain", partSize: 100},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := newTestService()
+			addr, _ := svc.slice(t, payload, tc.partSize)
+
+			r, err := stream.NewRangeReader(context.Background(), svc, addr)
+			require.NoError(t, err)
+			require.EqualValues(t, len(payload), r.Size())
+
+			for _, rng := range [][2]int{{0, 10}, {95, 110}, {150, 1000}, {999, 1000}} {
+				buf := make([]byte, rng[1]-rng[0])
+
+				n, err := r.ReadAt(buf, int64(rng[0]))
+				require.NoError(t, err)
+				require.Equal(t, len(buf), n)
+				require.Equal(t, payload[rng[0]:rng[1]], buf)
+			}
+
+			buf := make([]byte, 20)
+
+			n, err := r.ReadAt(buf, 990)
+			require.Equal(t, io.EOF, err)
+			require.Equal(t, 10, n)
+			require.Equal(t, payload[990:], buf[:n])
+
+			off, err := r.Seek(-100, io.SeekEnd)
+			require.NoError(t, err)
+			require.EqualValues(t, 900, off)
+
+			data, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			require.Equal(t, payload[900:], data)
+
+			_, err = r.Seek(500, io.SeekStart)
+			require.NoError(t, err)
+
+			w := new(bytes.Buffer)
+
+			written, err := r.WriteTo(w)
+			require.NoError(t, err)
+			require.EqualValues(t, 500, written)
+			require.Equal(t, payload[500:], w.Bytes())
+
+			_, err = r.Seek(-1, io.SeekStart)
+			require.Equal(t, stream.ErrNegativeOffset, err)
+		})
+	}
+
+	t.Run("read-ahead", func(t *testing.T) {
+		svc := newTestService()
+		addr, _ := svc.slice(t, payload, 2000)
+
+		r, err := stream.NewRangeReader(context.Background(), svc, addr, stream.WithReadAhead(100))
+		require.NoError(t, err)
+
+		buf := make([]byte, 10)
+
+		for i := 0; i < 10; i++ {
+			_, err := io.ReadFull(r, buf)
+			require.NoError(t, err)
+			require.Equal(t, payload[i*10:(i+1)*10], buf)
+		}
+
+		require.Equal(t, 1, svc.rangeRequests)
+	})
+
+	t.Run("lazy children", func(t *testing.T) {
+		svc := newTestService()
+		addr, _ := svc.slice(t, payload, 100)
+
+		r, err := stream.NewRangeReader(context.Background(), svc, addr)
+		require.NoError(t, err)
+
+		heads := svc.headRequests
+		buf := make([]byte, 10)
+
+		_, err = r.ReadAt(buf, 150)
+		require.NoError(t, err)
+		require.Equal(t, payload[150:160], buf)
+		require.Equal(t, heads+2, svc.headRequests)
+
+		_, err = r.ReadAt(buf, 0)
+		require.NoError(t, err)
+		require.Equal(t, payload[:10], buf)
+		require.Equal(t, heads+2, svc.headRequests)
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		svc := newTestService()
+		addr, _ := svc.slice(t, payload, 100)
+
+		r, err := stream.NewRangeReader(context.Background(), svc, addr)
+		require.NoError(t, err)
+
+		var (
+			wg   sync.WaitGroup
+			bufs = make([][]byte, 10)
+			errs = make([]error, 10)
+		)
+
+		for i := range bufs {
+			wg.Add(1)
+
+			go func(i int) {
+				defer wg.Done()
+
+				bufs[i] = make([]byte, 50)
+				_, errs[i] = r.ReadAt(bufs[i], int64(i*95))
+			}(i)
+		}
+
+		wg.Wait()
+
+		for i := range bufs {
+			require.NoError(t, errs[i])
+			require.Equal(t, payload[i*95:i*95+50], bufs[i])
+		}
+	})
+}
@@ -0,0 +1,325 @@
+package stream_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/signature"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// testService is an in-memory object.Service which stores objects
+// in a single container.
+type testService struct {
+	object.Service
+
+	chunkSize int
+
+	objects map[string]*object.Object
+
+	splits map[string]*object.SplitInfo
+
+	// protects request counters
+	mtx sync.Mutex
+
+	headRequests int
+
+	rangeRequests int
+
+	// context of the last Put stream
+	putCtx context.Context
+
+	// error returned on sending Put requests
+	putErr error
+}
+
+var errNotFound = errors.New("object not found")
+
+type getStream struct {
+	resps []*object.GetResponse
+}
+
+type getRangeStream struct {
+	resps []*object.GetRangeResponse
+}
+
+type putStream struct {
+	svc *testService
+
+	obj *object.Object
+}
+
+func newTestService() *testService {
+	return &testService{
+		chunkSize: 7,
+		objects:   make(map[string]*object.Object),
+		splits:    make(map[string]*object.SplitInfo),
+	}
+}
+
+func key(id *refs.ObjectID) string {
+	return hex.EncodeToString(id.GetValue())
+}
+
+func (s *testService) store(obj *object.Object) {
+	s.objects[key(obj.GetObjectID())] = obj
+
+	split := obj.GetHeader().GetSplit()
+	if split.GetParent() == nil {
+		return
+	}
+
+	info, ok := s.splits[key(split.GetParent())]
+	if !ok {
+		info = new(object.SplitInfo)
+		info.SetSplitID(split.GetSplitID())
+		s.splits[key(split.GetParent())] = info
+	}
+
+	if len(split.GetChildren()) > 0 {
+		info.SetLink(obj.GetObjectID())
+	} else {
+		info.SetLastPart(obj.GetObjectID())
+	}
+}
+
+func (s *testService) Head(_ context.Context, req *object.HeadRequest) (*object.HeadResponse, error) {
+	s.mtx.Lock()
+	s.headRequests++
+	s.mtx.Unlock()
+
+	id := req.GetBody().GetAddress().GetObjectID()
+
+	body := new(object.HeadResponseBody)
+
+	if obj, ok := s.objects[key(id)]; ok {
+		hdr := new(object.HeaderWithSignature)
+		hdr.SetHeader(obj.GetHeader())
+		hdr.SetSignature(obj.GetSignature())
+		body.SetHeaderPart(hdr)
+	} else if info, ok := s.splits[key(id)]; ok && req.GetBody().GetRaw() {
+		body.SetHeaderPart(info)
+	} else {
+		return nil, errNotFound
+	}
+
+	resp := new(object.HeadResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
+
+func (s *testService) Get(_ context.Context, req *object.GetRequest) (object.GetObjectStreamer, error) {
+	id := req.GetBody().GetAddress().GetObjectID()
+
+	obj, ok := s.objects[key(id)]
+	if !ok {
+		if info, ok := s.splits[key(id)]; ok && req.GetBody().GetRaw() {
+			stream := new(getStream)
+			stream.add(info)
+
+			return stream, nil
+		}
+
+		return nil, errNotFound
+	}
+
+	init := new(object.GetObjectPartInit)
+	init.SetObjectID(obj.GetObjectID())
+	init.SetSignature(obj.GetSignature())
+	init.SetHeader(obj.GetHeader())
+
+	stream := new(getStream)
+	stream.add(init)
+
+	for payload := obj.GetPayload(); len(payload) > 0; {
+		n := s.chunkSize
+		if n > len(payload) {
+			n = len(payload)
+		}
+
+		chunk := new(object.GetObjectPartChunk)
+		chunk.SetChunk(payload[:n])
+		stream.add(chunk)
+
+		payload = payload[n:]
+	}
+
+	return stream, nil
+}
+
+func (s *testService) GetRange(_ context.Context, req *object.GetRangeRequest) (object.GetRangeObjectStreamer, error) {
+	s.mtx.Lock()
+	s.rangeRequests++
+	s.mtx.Unlock()
+
+	obj, ok := s.objects[key(req.GetBody().GetAddress().GetObjectID())]
+	if !ok {
+		return nil, errNotFound
+	}
+
+	rng := req.GetBody().GetRange()
+	if rng.GetOffset()+rng.GetLength() > uint64(len(obj.GetPayload())) {
+		return nil, errors.New("range out of bounds")
+	}
+
+	stream := new(getRangeStream)
+
+	for payload := obj.GetPayload()[rng.GetOffset() : rng.GetOffset()+rng.GetLength()]; len(payload) > 0; {
+		n := s.chunkSize
+		if n > len(payload) {
+			n = len(payload)
+		}
+
+		chunk := new(object.GetRangePartChunk)
+		chunk.SetChunk(payload[:n])
+
+		body := new(object.GetRangeResponseBody)
+		body.SetRangePart(chunk)
+
+		resp := new(object.GetRangeResponse)
+		resp.SetBody(body)
+
+		stream.resps = append(stream.resps, resp)
+		payload = payload[n:]
+	}
+
+	return stream, nil
+}
+
+func (s *getRangeStream) Recv() (*object.GetRangeResponse, error) {
+	if len(s.resps) == 0 {
+		return nil, io.EOF
+	}
+
+	resp := s.resps[0]
+	s.resps = s.resps[1:]
+
+	return resp, nil
+}
+
+func (s *testService) Put(ctx context.Context) (object.PutObjectStreamer, error) {
+	s.putCtx = ctx
+
+	return &putStream{svc: s}, nil
+}
+
+func (s *putStream) Send(req *object.PutRequest) error {
+	if s.svc.putErr != nil {
+		return s.svc.putErr
+	}
+
+	switch v := req.GetBody().GetObjectPart().(type) {
+	case *object.PutObjectPartInit:
+		if s.obj != nil {
+			return errors.New("repeated init part")
+		}
+
+		s.obj = new(object.Object)
+		s.obj.SetObjectID(v.GetObjectID())
+		s.obj.SetSignature(v.GetSignature())
+		s.obj.SetHeader(v.GetHeader())
+	case *object.PutObjectPartChunk:
+		if s.obj == nil {
+			return errors.New("chunk before init part")
+		}
+
+		if len(v.GetChunk()) > s.svc.chunkSize {
+			return errors.New("chunk is too big")
+		}
+
+		s.obj.SetPayload(append(s.obj.GetPayload(), v.GetChunk()...))
+	default:
+		return errors.Errorf("unexpected object part %T", v)
+	}
+
+	return nil
+}
+
+func (s *putStream) CloseAndRecv() (*object.PutResponse, error) {
+	if s.obj == nil {
+		return nil, errors.New("missing init part")
+	}
+
+	s.svc.store(s.obj)
+
+	body := new(object.PutResponseBody)
+	body.SetObjectID(s.obj.GetObjectID())
+
+	resp := new(object.PutResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
+
+func (s *getStream) add(part object.GetObjectPart) {
+	body := new(object.GetResponseBody)
+	body.SetObjectPart(part)
+
+	resp := new(object.GetResponse)
+	resp.SetBody(body)
+
+	s.resps = append(s.resps, resp)
+}
+
+func (s *getStream) Recv() (*object.GetResponse, error) {
+	if len(s.resps) == 0 {
+		return nil, io.EOF
+	}
+
+	resp := s.resps[0]
+	s.resps = s.resps[1:]
+
+	return resp, nil
+}
+
+func testHeader() *object.Header {
+	cid := new(refs.ContainerID)
+	cid.SetValue([]byte("Container ID"))
+
+	owner := new(refs.OwnerID)
+	owner.SetValue([]byte("Owner ID"))
+
+	hdr := new(object.Header)
+	hdr.SetContainerID(cid)
+	hdr.SetOwnerID(owner)
+	hdr.SetCreationEpoch(10)
+	hdr.SetObjectType(object.TypeRegular)
+
+	return hdr
+}
+
+// slice stores the payload in the service as a chain of objects and
+// returns them in the order of creation.
+func (s *testService) slice(t *testing.T, payload []byte, partSize uint64) (*refs.Address, []*object.Object) {
+	hdr := testHeader()
+
+	slicer := object.NewSlicer(hdr, test.DecodeKey(0),
+		object.WithPartSize(partSize),
+		object.WithSignOptions(signature.SignWithRFC6979()),
+	)
+
+	var objs []*object.Object
+
+	id, err := slicer.Slice(bytes.NewReader(payload), func(obj *object.Object) error {
+		s.store(obj)
+		objs = append(objs, obj)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	addr := new(refs.Address)
+	addr.SetContainerID(hdr.GetContainerID())
+	addr.SetObjectID(id)
+
+	return addr, objs
+}
@@ -0,0 +1,138 @@
+/*
+Package stream contains helpers over object.Service streams: split object
+assembler, payload readers and writers.
+*/
+package stream
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/session"
+	"github.com/cthulhu-rider/neofs-api-go/v2/signature"
+	"github.com/pkg/errors"
+)
+
+// Option is a configuration option of the helpers.
+type Option func(*cfg)
+
+type cfg struct {
+	key *ecdsa.PrivateKey
+
+	meta *session.RequestMetaHeader
+
+	raw bool
+
+	chunkSize uint32
+
+	readAhead uint64
+
+	verify bool
+}
+
+type serviceRequest interface {
+	SetMetaHeader(*session.RequestMetaHeader)
+}
+
+func defaultCfg() *cfg {
+	return new(cfg)
+}
+
+func newCfg(opts ...Option) *cfg {
+	c := defaultCfg()
+
+	for i := range opts {
+		opts[i](c)
+	}
+
+	return c
+}
+
+// WithKey returns option to sign requests with the private key.
+//
+// By default, requests are not signed.
+func WithKey(v *ecdsa.PrivateKey) Option {
+	return func(c *cfg) {
+		c.key = v
+	}
+}
+
+// WithMetaHeader returns option to attach meta header to requests.
+func WithMetaHeader(v *session.RequestMetaHeader) Option {
+	return func(c *cfg) {
+		c.meta = v
+	}
+}
+
+// WithRaw returns option to request the object without assembling
+// the split chain on the server side.
+func WithRaw(v bool) Option {
+	return func(c *cfg) {
+		c.raw = v
+	}
+}
+
+func (c *cfg) prepare(req serviceRequest) error {
+	req.SetMetaHeader(c.meta)
+
+	if c.key == nil {
+		return nil
+	}
+
+	return errors.Wrap(signature.SignServiceMessage(c.key, req), "could not sign request")
+}
+
+func (c *cfg) head(ctx context.Context, svc object.Service, addr *refs.Address, raw bool) (object.GetHeaderPart, error) {
+	body := new(object.HeadRequestBody)
+	body.SetAddress(addr)
+	body.SetRaw(raw)
+
+	req := new(object.HeadRequest)
+	req.SetBody(body)
+
+	if err := c.prepare(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := svc.Head(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not send head request")
+	}
+
+	part := resp.GetBody().GetHeaderPart()
+	if part == nil {
+		return nil, errors.New("empty header part in response")
+	}
+
+	return part, nil
+}
+
+func (c *cfg) get(ctx context.Context, svc object.Service, addr *refs.Address, raw bool) (object.GetObjectStreamer, error) {
+	body := new(object.GetRequestBody)
+	body.SetAddress(addr)
+	body.SetRaw(raw)
+
+	req := new(object.GetRequest)
+	req.SetBody(body)
+
+	if err := c.prepare(req); err != nil {
+		return nil, err
+	}
+
+	s, err := svc.Get(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not send get request")
+	}
+
+	return s, nil
+}
+
+func address(cid *refs.ContainerID, id *refs.ObjectID) *refs.Address {
+	addr := new(refs.Address)
+	addr.SetContainerID(cid)
+	addr.SetObjectID(id)
+
+	return addr
+}
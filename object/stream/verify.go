@@ -0,0 +1,162 @@
+package stream
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/tz"
+	"github.com/pkg/errors"
+)
+
+// IntegrityError is returned by verifying payload reader when received
+// payload does not match the object header.
+type IntegrityError struct {
+	// Field is a name of the mismatched header field.
+	Field string
+
+	// Msg describes the mismatch.
+	Msg string
+}
+
+func (e *IntegrityError) Error() string {
+	return fmt.Sprintf("payload integrity violation: %s: %s", e.Field, e.Msg)
+}
+
+const (
+	fieldPayloadLength   = "payload length"
+	fieldPayloadHash     = "payload checksum"
+	fieldHomomorphicHash = "homomorphic checksum"
+)
+
+type checksumVerifier struct {
+	field string
+
+	exp []byte
+
+	h hash.Hash
+}
+
+type verifyingReader struct {
+	r io.ReadCloser
+
+	ln, read uint64
+
+	checksums []checksumVerifier
+
+	err error
+}
+
+// WithVerification returns option to verify the payload received
+// by Get against the length and checksums from the object header.
+//
+// Checksums are calculated while reading, the result is checked at
+// the end of the payload. The option also applies to the child objects
+// read by Assembler.
+func WithVerification() Option {
+	return func(c *cfg) {
+		c.verify = true
+	}
+}
+
+func newVerifyingReader(r io.ReadCloser, hdr *object.Header) (*verifyingReader, error) {
+	v := &verifyingReader{
+		r:  r,
+		ln: hdr.GetPayloadLength(),
+	}
+
+	for _, cs := range []struct {
+		field string
+		cs    *refs.Checksum
+	}{
+		{field: fieldPayloadHash, cs: hdr.GetPayloadHash()},
+		{field: fieldHomomorphicHash, cs: hdr.GetHomomorphicHash()},
+	} {
+		if cs.cs == nil {
+			continue
+		}
+
+		var h hash.Hash
+
+		switch typ := cs.cs.GetType(); typ {
+		case refs.SHA256:
+			h = sha256.New()
+		case refs.TillichZemor:
+			h = tz.New()
+		default:
+			return nil, errors.Wrapf(object.ErrUnsupportedChecksum, "%s: %d", cs.field, typ)
+		}
+
+		v.checksums = append(v.checksums, checksumVerifier{
+			field: cs.field,
+			exp:   cs.cs.GetSum(),
+			h:     h,
+		})
+	}
+
+	return v, nil
+}
+
+func (r *verifyingReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	n, err := r.r.Read(p)
+
+	if r.read += uint64(n); r.read > r.ln {
+		r.err = &IntegrityError{
+			Field: fieldPayloadLength,
+			Msg:   fmt.Sprintf("received more than %d bytes", r.ln),
+		}
+
+		return 0, r.err
+	}
+
+	for i := range r.checksums {
+		_, _ = r.checksums[i].h.Write(p[:n])
+	}
+
+	switch {
+	case errors.Is(err, io.EOF):
+		if r.err = r.verify(); r.err == nil {
+			r.err = io.EOF
+		}
+	case err != nil:
+		r.err = err
+	}
+
+	if n > 0 {
+		return n, nil
+	}
+
+	return 0, r.err
+}
+
+func (r *verifyingReader) verify() error {
+	if r.read != r.ln {
+		return &IntegrityError{
+			Field: fieldPayloadLength,
+			Msg:   fmt.Sprintf("expected %d bytes, received %d", r.ln, r.read),
+		}
+	}
+
+	for _, cs := range r.checksums {
+		if sum := cs.h.Sum(nil); !bytes.Equal(sum, cs.exp) {
+			return &IntegrityError{
+				Field: cs.field,
+				Msg:   fmt.Sprintf("expected %x, calculated %x", cs.exp, sum),
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *verifyingReader) Close() error {
+	return r.r.Close()
+}
@@ -0,0 +1,104 @@
+package stream_test
+
+import (
+	"context"
+	"crypto/rand"
+	"io/ioutil"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/object/stream"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/signature"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet_Verification(t *testing.T) {
+	payload := make([]byte, 100)
+
+	_, err := rand.Read(payload)
+	require.NoError(t, err)
+
+	// stores the object with the header calculated for the payload
+	// and the payload modified by corrupt
+	store := func(t *testing.T, corrupt func([]byte) []byte) (*testService, *refs.Address) {
+		svc := newTestService()
+
+		obj := new(object.Object)
+		obj.SetHeader(testHeader())
+		obj.SetPayload(payload)
+		require.NoError(t, object.SetVerificationFields(test.DecodeKey(0), obj, signature.SignWithRFC6979()))
+
+		obj.SetPayload(corrupt(append([]byte{}, payload...)))
+		svc.store(obj)
+
+		return svc, addressOf(obj)
+	}
+
+	read := func(svc *testService, addr *refs.Address, opts ...stream.Option) ([]byte, error) {
+		_, r, err := stream.Get(context.Background(), svc, addr, opts...)
+		require.NoError(t, err)
+
+		defer r.Close()
+
+		return ioutil.ReadAll(r)
+	}
+
+	t.Run("correct payload", func(t *testing.T) {
+		svc, addr := store(t, func(p []byte) []byte { return p })
+
+		data, err := read(svc, addr, stream.WithVerification())
+		require.NoError(t, err)
+		require.Equal(t, payload, data)
+	})
+
+	for _, tc := range []struct {
+		name    string
+		field   string
+		corrupt func([]byte) []byte
+	}{
+		{
+			name:    "corrupted payload",
+			field:   "payload checksum",
+			corrupt: func(p []byte) []byte { p[50]++; return p },
+		},
+		{
+			name:    "truncated payload",
+			field:   "payload length",
+			corrupt: func(p []byte) []byte { return p[:99] },
+		},
+		{
+			name:    "oversized payload",
+			field:   "payload length",
+			corrupt: func(p []byte) []byte { return append(p, 1) },
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			svc, addr := store(t, tc.corrupt)
+
+			_, err := read(svc, addr)
+			require.NoError(t, err)
+
+			_, err = read(svc, addr, stream.WithVerification())
+
+			var e *stream.IntegrityError
+			require.True(t, errors.As(err, &e), err)
+			require.Equal(t, tc.field, e.Field)
+		})
+	}
+
+	t.Run("corrupted homomorphic checksum", func(t *testing.T) {
+		svc, addr := store(t, func(p []byte) []byte { return p })
+
+		cs := svc.objects[key(addr.GetObjectID())].GetHeader().GetHomomorphicHash()
+		cs.GetSum()[0]++
+
+		_, err := read(svc, addr, stream.WithVerification())
+
+		var e *stream.IntegrityError
+		require.True(t, errors.As(err, &e), err)
+		require.Equal(t, "homomorphic checksum", e.Field)
+	})
+}
@@ -0,0 +1,265 @@
+package object
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"strconv"
+	"strings"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/internal/attribute"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/storagegroup"
+	"github.com/cthulhu-rider/neofs-api-go/v2/tombstone"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/tz"
+	"github.com/pkg/errors"
+)
+
+// ErrMissingField is returned when required field of the object
+// header is not set.
+var ErrMissingField = errors.New("missing required field")
+
+// ErrUnknownType is returned when object type is not supported.
+var ErrUnknownType = errors.New("unknown object type")
+
+// ErrInvalidChecksum is returned when checksum type or length is incorrect.
+var ErrInvalidChecksum = errors.New("invalid checksum")
+
+// ErrInvalidIDLength is returned when identifier has incorrect length.
+var ErrInvalidIDLength = errors.New("invalid identifier length")
+
+// ErrInvalidAttribute is returned when object attribute is malformed.
+var ErrInvalidAttribute = attribute.ErrInvalid
+
+// ErrInvalidSplitHeader is returned when fields of the split header
+// are inconsistent.
+var ErrInvalidSplitHeader = errors.New("invalid split header")
+
+// ErrInvalidPayload is returned when object payload cannot be decoded
+// according to object type.
+var ErrInvalidPayload = errors.New("invalid payload")
+
+const (
+	containerIDLength = sha256.Size
+	ownerIDLength     = refs.OwnerIDSize
+	splitIDLength     = 16
+)
+
+// numericSysAttributes contains system attributes with numeric values.
+var numericSysAttributes = map[string]struct{}{
+	SysAttributeExpEpoch: {},
+}
+
+// ValidateHeader checks that object header is well formed.
+//
+// Version, container ID, owner ID and payload checksum are required.
+// Identifiers must have correct length, checksums must be of supported
+// type and correct length. Attribute keys must be unique and non-empty,
+// numeric system attributes must be decimal numbers. Tombstones must have
+// SysAttributeExpEpoch attribute. Split header is
+// allowed for regular objects only and its fields must be consistent:
+// parent header requires parent ID and signature, parent ID must match
+// parent header, linking object must not have payload.
+//
+// Returns an error wrapping one of the package errors.
+func ValidateHeader(hdr *Header) error {
+	return validateHeader(hdr, true)
+}
+
+func validateHeader(hdr *Header, allowSplit bool) error {
+	if hdr == nil {
+		return errors.Wrap(ErrMissingField, "header")
+	}
+
+	if hdr.GetVersion() == nil {
+		return errors.Wrap(ErrMissingField, "version")
+	}
+
+	if err := validateID(hdr.GetContainerID().GetValue(), containerIDLength, "container ID"); err != nil {
+		return err
+	}
+
+	if err := validateID(hdr.GetOwnerID().GetValue(), ownerIDLength, "owner ID"); err != nil {
+		return err
+	}
+
+	switch hdr.GetObjectType() {
+	case TypeRegular, TypeTombstone, TypeStorageGroup:
+	default:
+		return errors.Wrapf(ErrUnknownType, "%d", hdr.GetObjectType())
+	}
+
+	if hdr.GetPayloadHash() == nil {
+		return errors.Wrap(ErrMissingField, "payload checksum")
+	}
+
+	if err := validateChecksum(hdr.GetPayloadHash(), refs.SHA256, sha256.Size); err != nil {
+		return errors.Wrap(err, "payload checksum")
+	}
+
+	if cs := hdr.GetHomomorphicHash(); cs != nil {
+		if err := validateChecksum(cs, refs.TillichZemor, tz.Size); err != nil {
+			return errors.Wrap(err, "homomorphic checksum")
+		}
+	}
+
+	if err := validateAttributes(hdr.GetAttributes()); err != nil {
+		return err
+	}
+
+	if hdr.GetObjectType() == TypeTombstone {
+		if _, ok, _ := hdr.attribute(SysAttributeExpEpoch); !ok {
+			return errors.Wrapf(ErrMissingField, "%s attribute of tombstone", SysAttributeExpEpoch)
+		}
+	}
+
+	if split := hdr.GetSplit(); split != nil {
+		if !allowSplit {
+			return errors.Wrap(ErrInvalidSplitHeader, "split header in parent header")
+		}
+
+		if hdr.GetObjectType() != TypeRegular {
+			return errors.Wrapf(ErrInvalidSplitHeader, "split header in %s object", hdr.GetObjectType())
+		}
+
+		if err := validateSplitHeader(hdr, split); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateID(v []byte, ln int, name string) error {
+	switch len(v) {
+	case 0:
+		return errors.Wrap(ErrMissingField, name)
+	case ln:
+		return nil
+	default:
+		return errors.Wrapf(ErrInvalidIDLength, "%s: expected %d, got %d", name, ln, len(v))
+	}
+}
+
+func validateChecksum(cs *refs.Checksum, typ refs.ChecksumType, ln int) error {
+	if cs.GetType() != typ {
+		return errors.Wrapf(ErrInvalidChecksum, "expected type %d, got %d", typ, cs.GetType())
+	}
+
+	if len(cs.GetSum()) != ln {
+		return errors.Wrapf(ErrInvalidChecksum, "expected length %d, got %d", ln, len(cs.GetSum()))
+	}
+
+	return nil
+}
+
+func validateAttributes(as []*Attribute) error {
+	keys := make(map[string]struct{}, len(as))
+
+	for i := range as {
+		key := as[i].GetKey()
+		if key == "" {
+			return errors.Wrapf(ErrInvalidAttribute, "empty key of attribute #%d", i)
+		}
+
+		if _, ok := keys[key]; ok {
+			return errors.Wrapf(ErrInvalidAttribute, "duplicate key %s", key)
+		}
+
+		keys[key] = struct{}{}
+
+		if !strings.HasPrefix(key, SysAttributePrefix) {
+			continue
+		}
+
+		if _, ok := numericSysAttributes[key]; ok {
+			if _, err := strconv.ParseUint(as[i].GetValue(), 10, 64); err != nil {
+				return errors.Wrapf(ErrInvalidAttribute, "non-numeric value of %s", key)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateSplitHeader(hdr *Header, split *SplitHeader) error {
+	if id := split.GetSplitID(); id != nil && len(id) != splitIDLength {
+		return errors.Wrapf(ErrInvalidSplitHeader, "split ID length: expected %d, got %d", splitIDLength, len(id))
+	}
+
+	if split.GetSplitID() == nil && split.GetParent() == nil && split.GetPrevious() == nil && len(split.GetChildren()) == 0 {
+		return errors.Wrap(ErrInvalidSplitHeader, "empty split header")
+	}
+
+	if split.GetParent() == nil && (split.GetParentSignature() != nil || split.GetParentHeader() != nil) {
+		return errors.Wrap(ErrInvalidSplitHeader, "parent signature or header without parent ID")
+	}
+
+	if par := split.GetParentHeader(); par != nil {
+		if split.GetParentSignature() == nil {
+			return errors.Wrap(ErrInvalidSplitHeader, "parent header without parent signature")
+		}
+
+		if err := validateHeader(par, false); err != nil {
+			return errors.Wrap(err, "parent header")
+		}
+
+		id, err := CalculateID(par)
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(id.GetValue(), split.GetParent().GetValue()) {
+			return errors.Wrap(ErrInvalidSplitHeader, "parent ID does not match parent header")
+		}
+	}
+
+	if len(split.GetChildren()) > 0 {
+		switch {
+		case split.GetParent() == nil:
+			return errors.Wrap(ErrInvalidSplitHeader, "children list without parent ID")
+		case split.GetPrevious() != nil:
+			return errors.Wrap(ErrInvalidSplitHeader, "children list with previous object ID")
+		case hdr.GetPayloadLength() != 0:
+			return errors.Wrap(ErrInvalidSplitHeader, "linking object with payload")
+		}
+	}
+
+	return nil
+}
+
+// ValidateObject checks that object header is well formed according to
+// ValidateHeader and that payload of tombstone and storage group objects
+// can be decoded and lists at least one member.
+func ValidateObject(obj *Object) error {
+	if err := ValidateHeader(obj.GetHeader()); err != nil {
+		return err
+	}
+
+	var (
+		err     error
+		members []*refs.ObjectID
+		typ     = obj.GetHeader().GetObjectType()
+	)
+
+	switch typ {
+	case TypeTombstone:
+		ts := new(tombstone.Tombstone)
+		err = ts.StableUnmarshal(obj.GetPayload())
+		members = ts.GetMembers()
+	case TypeStorageGroup:
+		sg := new(storagegroup.StorageGroup)
+		err = sg.StableUnmarshal(obj.GetPayload())
+		members = sg.GetMembers()
+	default:
+		return nil
+	}
+
+	switch {
+	case err != nil:
+		return errors.Wrapf(ErrInvalidPayload, "%s: %v", typ, err)
+	case len(members) == 0:
+		return errors.Wrapf(ErrInvalidPayload, "%s without members", typ)
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package object_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/cthulhu-rider/neofs-api-go/v2/storagegroup"
+	"github.com/cthulhu-rider/neofs-api-go/v2/tombstone"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/signature"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func validHeader() *object.Header {
+	ver := new(refs.Version)
+	ver.SetMajor(2)
+
+	cid := new(refs.ContainerID)
+	cid.SetValue(make([]byte, sha256.Size))
+
+	owner := new(refs.OwnerID)
+	owner.SetValue(make([]byte, 25))
+
+	attr := new(object.Attribute)
+	attr.SetKey(object.SysAttributeExpEpoch)
+	attr.SetValue("100")
+
+	hdr := new(object.Header)
+	hdr.SetVersion(ver)
+	hdr.SetContainerID(cid)
+	hdr.SetOwnerID(owner)
+	hdr.SetPayloadHash(object.CalculatePayloadChecksum(nil))
+	hdr.SetHomomorphicHash(object.CalculateHomomorphicChecksum(nil))
+	hdr.SetAttributes([]*object.Attribute{attr})
+
+	return hdr
+}
+
+func TestValidateHeader(t *testing.T) {
+	require.NoError(t, object.ValidateHeader(validHeader()))
+
+	for _, tc := range []struct {
+		name   string
+		err    error
+		modify func(*object.Header)
+	}{
+		{
+			name:   "missing version",
+			err:    object.ErrMissingField,
+			modify: func(h *object.Header) { h.SetVersion(nil) },
+		},
+		{
+			name:   "missing container ID",
+			err:    object.ErrMissingField,
+			modify: func(h *object.Header) { h.SetContainerID(nil) },
+		},
+		{
+			name: "short owner ID",
+			err:  object.ErrInvalidIDLength,
+			modify: func(h *object.Header) {
+				h.GetOwnerID().SetValue([]byte{1})
+			},
+		},
+		{
+			name:   "unknown type",
+			err:    object.ErrUnknownType,
+			modify: func(h *object.Header) { h.SetObjectType(10) },
+		},
+		{
+			name:   "missing payload checksum",
+			err:    object.ErrMissingField,
+			modify: func(h *object.Header) { h.SetPayloadHash(nil) },
+		},
+		{
+			name: "wrong checksum type",
+			err:  object.ErrInvalidChecksum,
+			modify: func(h *object.Header) {
+				h.SetPayloadHash(object.CalculateHomomorphicChecksum(nil))
+			},
+		},
+		{
+			name: "wrong checksum length",
+			err:  object.ErrInvalidChecksum,
+			modify: func(h *object.Header) {
+				h.GetHomomorphicHash().SetSum([]byte{1})
+			},
+		},
+		{
+			name: "duplicate attribute",
+			err:  object.ErrInvalidAttribute,
+			modify: func(h *object.Header) {
+				h.SetAttributes(append(h.GetAttributes(), h.GetAttributes()[0]))
+			},
+		},
+		{
+			name: "empty attribute key",
+			err:  object.ErrInvalidAttribute,
+			modify: func(h *object.Header) {
+				h.SetAttributes(append(h.GetAttributes(), new(object.Attribute)))
+			},
+		},
+		{
+			name: "non-numeric expiration",
+			err:  object.ErrInvalidAttribute,
+			modify: func(h *object.Header) {
+				h.GetAttributes()[0].SetValue("tomorrow")
+			},
+		},
+		{
+			name: "tombstone without expiration",
+			err:  object.ErrMissingField,
+			modify: func(h *object.Header) {
+				h.SetObjectType(object.TypeTombstone)
+				h.SetAttributes(nil)
+			},
+		},
+		{
+			name: "empty split header",
+			err:  object.ErrInvalidSplitHeader,
+			modify: func(h *object.Header) {
+				h.SetSplit(new(object.SplitHeader))
+			},
+		},
+		{
+			name: "split tombstone",
+			err:  object.ErrInvalidSplitHeader,
+			modify: func(h *object.Header) {
+				split := new(object.SplitHeader)
+				split.SetSplitID(make([]byte, 16))
+
+				h.SetObjectType(object.TypeTombstone)
+				h.SetSplit(split)
+			},
+		},
+		{
+			name: "parent header without parent ID",
+			err:  object.ErrInvalidSplitHeader,
+			modify: func(h *object.Header) {
+				split := new(object.SplitHeader)
+				split.SetSplitID(make([]byte, 16))
+				split.SetParentHeader(validHeader())
+
+				h.SetSplit(split)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			hdr := validHeader()
+			tc.modify(hdr)
+
+			err := object.ValidateHeader(hdr)
+			require.True(t, errors.Is(err, tc.err), err)
+		})
+	}
+
+	t.Run("split chain", func(t *testing.T) {
+		s := object.NewSlicer(validHeader(), test.DecodeKey(0),
+			object.WithPartSize(10),
+			object.WithSignOptions(signature.SignWithRFC6979()),
+		)
+
+		var objs []*object.Object
+
+		_, err := s.Slice(bytes.NewReader(make([]byte, 25)), func(obj *object.Object) error {
+			require.NoError(t, object.ValidateObject(obj))
+
+			objs = append(objs, obj)
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		split := objs[len(objs)-2].GetHeader().GetSplit()
+		split.GetParentHeader().SetCreationEpoch(1)
+
+		err = object.ValidateHeader(objs[len(objs)-2].GetHeader())
+		require.True(t, errors.Is(err, object.ErrInvalidSplitHeader), err)
+	})
+}
+
+func TestValidateObject(t *testing.T) {
+	id := new(refs.ObjectID)
+	id.SetValue(make([]byte, sha256.Size))
+
+	tomb := new(tombstone.Tombstone)
+	tomb.SetExpirationEpoch(10)
+	tomb.SetMembers([]*refs.ObjectID{id})
+
+	sg := new(storagegroup.StorageGroup)
+	sg.SetMembers([]*refs.ObjectID{id})
+
+	for _, tc := range []struct {
+		name  string
+		typ   object.Type
+		msg   interface{ StableMarshal([]byte) ([]byte, error) }
+		valid bool
+	}{
+		{name: "tombstone", typ: object.TypeTombstone, msg: tomb, valid: true},
+		{name: "empty tombstone", typ: object.TypeTombstone, msg: new(tombstone.Tombstone)},
+		{name: "storage group", typ: object.TypeStorageGroup, msg: sg, valid: true},
+		{name: "empty storage group", typ: object.TypeStorageGroup, msg: new(storagegroup.StorageGroup)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			payload, err := tc.msg.StableMarshal(nil)
+			require.NoError(t, err)
+
+			obj := new(object.Object)
+			obj.SetHeader(validHeader())
+			obj.GetHeader().SetObjectType(tc.typ)
+			obj.SetPayload(payload)
+
+			err = object.ValidateObject(obj)
+			if tc.valid {
+				require.NoError(t, err)
+				return
+			}
+
+			require.True(t, errors.Is(err, object.ErrInvalidPayload), err)
+
+			obj.SetPayload(nil)
+
+			err = object.ValidateObject(obj)
+			require.True(t, errors.Is(err, object.ErrInvalidPayload), err)
+
+			obj.SetPayload([]byte{0xff})
+
+			err = object.ValidateObject(obj)
+			require.True(t, errors.Is(err, object.ErrInvalidPayload), err)
+		})
+	}
+}
@@ -1,9 +1,7 @@
 package refs
 
 import (
-	refs "github.com/cthulhu-rider/neofs-api-go/v2/refs/grpc"
 	"github.com/cthulhu-rider/neofs-api-go/v2/util/proto"
-	goproto "google.golang.org/protobuf/proto"
 )
 
 const (
@@ -51,15 +49,25 @@ func (o *OwnerID) StableSize() int {
 	return proto.BytesSize(ownerIDValField, o.val)
 }
 
-func (o *OwnerID) Unmarshal(data []byte) error {
-	m := new(refs.OwnerID)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+func (o *OwnerID) StableUnmarshal(data []byte) error {
+	if o == nil {
+		return nil
 	}
 
-	*o = *OwnerIDFromGRPCMessage(m)
+	*o = OwnerID{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case ownerIDValField:
+			o.val, err = f.Bytes()
+		}
 
-	return nil
+		return
+	})
+}
+
+func (o *OwnerID) Unmarshal(data []byte) error {
+	return o.StableUnmarshal(data)
 }
 
 func (c *ContainerID) StableMarshal(buf []byte) ([]byte, error) {
@@ -87,15 +95,25 @@ func (c *ContainerID) StableSize() int {
 	return proto.BytesSize(containerIDValField, c.val)
 }
 
-func (c *ContainerID) Unmarshal(data []byte) error {
-	m := new(refs.ContainerID)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+func (c *ContainerID) StableUnmarshal(data []byte) error {
+	if c == nil {
+		return nil
 	}
 
-	*c = *ContainerIDFromGRPCMessage(m)
+	*c = ContainerID{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case containerIDValField:
+			c.val, err = f.Bytes()
+		}
 
-	return nil
+		return
+	})
+}
+
+func (c *ContainerID) Unmarshal(data []byte) error {
+	return c.StableUnmarshal(data)
 }
 
 func (o *ObjectID) StableMarshal(buf []byte) ([]byte, error) {
@@ -150,15 +168,25 @@ func ObjectIDNestedListMarshal(fNum int64, buf []byte, ids []*ObjectID) (off int
 	return
 }
 
-func (o *ObjectID) Unmarshal(data []byte) error {
-	m := new(refs.ObjectID)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+func (o *ObjectID) StableUnmarshal(data []byte) error {
+	if o == nil {
+		return nil
 	}
 
-	*o = *ObjectIDFromGRPCMessage(m)
+	*o = ObjectID{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case objectIDValField:
+			o.val, err = f.Bytes()
+		}
+
+		return
+	})
+}
 
-	return nil
+func (o *ObjectID) Unmarshal(data []byte) error {
+	return o.StableUnmarshal(data)
 }
 
 func (a *Address) StableMarshal(buf []byte) ([]byte, error) {
@@ -202,15 +230,29 @@ func (a *Address) StableSize() (size int) {
 	return size
 }
 
-func (a *Address) Unmarshal(data []byte) error {
-	addrGRPC := new(refs.Address)
-	if err := goproto.Unmarshal(data, addrGRPC); err != nil {
-		return err
+func (a *Address) StableUnmarshal(data []byte) error {
+	if a == nil {
+		return nil
 	}
 
-	*a = *AddressFromGRPCMessage(addrGRPC)
+	*a = Address{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) error {
+		switch f.Num {
+		case addressContainerField:
+			a.cid = new(ContainerID)
+			return f.Nested(a.cid)
+		case addressObjectField:
+			a.oid = new(ObjectID)
+			return f.Nested(a.oid)
+		}
+
+		return nil
+	})
+}
 
-	return nil
+func (a *Address) Unmarshal(data []byte) error {
+	return a.StableUnmarshal(data)
 }
 
 func (c *Checksum) StableMarshal(buf []byte) ([]byte, error) {
@@ -253,15 +295,30 @@ func (c *Checksum) StableSize() (size int) {
 	return size
 }
 
-func (c *Checksum) Unmarshal(data []byte) error {
-	m := new(refs.Checksum)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+func (c *Checksum) StableUnmarshal(data []byte) error {
+	if c == nil {
+		return nil
 	}
 
-	*c = *ChecksumFromGRPCMessage(m)
+	*c = Checksum{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case checksumTypeField:
+			var typ int32
 
-	return nil
+			typ, err = f.Enum()
+			c.typ = ChecksumType(typ)
+		case checksumValueField:
+			c.sum, err = f.Bytes()
+		}
+
+		return
+	})
+}
+
+func (c *Checksum) Unmarshal(data []byte) error {
+	return c.StableUnmarshal(data)
 }
 
 func (s *Signature) StableMarshal(buf []byte) ([]byte, error) {
@@ -304,15 +361,27 @@ func (s *Signature) StableSize() (size int) {
 	return size
 }
 
-func (s *Signature) Unmarshal(data []byte) error {
-	m := new(refs.Signature)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+func (s *Signature) StableUnmarshal(data []byte) error {
+	if s == nil {
+		return nil
 	}
 
-	*s = *SignatureFromGRPCMessage(m)
+	*s = Signature{}
 
-	return nil
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case signatureKeyField:
+			s.key, err = f.Bytes()
+		case signatureValueField:
+			s.sign, err = f.Bytes()
+		}
+
+		return
+	})
+}
+
+func (s *Signature) Unmarshal(data []byte) error {
+	return s.StableUnmarshal(data)
 }
 
 func (v *Version) StableMarshal(buf []byte) ([]byte, error) {
@@ -355,13 +424,25 @@ func (v *Version) StableSize() (size int) {
 	return size
 }
 
-func (v *Version) Unmarshal(data []byte) error {
-	m := new(refs.Version)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+func (v *Version) StableUnmarshal(data []byte) error {
+	if v == nil {
+		return nil
 	}
 
-	*v = *VersionFromGRPCMessage(m)
+	*v = Version{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case versionMajorField:
+			v.major, err = f.UInt32()
+		case versionMinorField:
+			v.minor, err = f.UInt32()
+		}
+
+		return
+	})
+}
 
-	return nil
+func (v *Version) Unmarshal(data []byte) error {
+	return v.StableUnmarshal(data)
 }
@@ -20,6 +20,10 @@ func TestOwnerID_StableMarshal(t *testing.T) {
 		require.NoError(t, ownerTo.Unmarshal(wire))
 
 		require.Equal(t, ownerFrom, ownerTo)
+
+		ownerTo = new(refs.OwnerID)
+		require.NoError(t, ownerTo.StableUnmarshal(wire))
+		require.Equal(t, ownerFrom, ownerTo)
 	})
 }
 
@@ -36,6 +40,10 @@ func TestContainerID_StableMarshal(t *testing.T) {
 		require.NoError(t, cnrTo.Unmarshal(wire))
 
 		require.Equal(t, cnrFrom, cnrTo)
+
+		cnrTo = new(refs.ContainerID)
+		require.NoError(t, cnrTo.StableUnmarshal(wire))
+		require.Equal(t, cnrFrom, cnrTo)
 	})
 }
 
@@ -52,6 +60,10 @@ func TestObjectID_StableMarshal(t *testing.T) {
 		require.NoError(t, objectIDTo.Unmarshal(wire))
 
 		require.Equal(t, objectIDFrom, objectIDTo)
+
+		objectIDTo = new(refs.ObjectID)
+		require.NoError(t, objectIDTo.StableUnmarshal(wire))
+		require.Equal(t, objectIDFrom, objectIDTo)
 	})
 }
 
@@ -69,6 +81,10 @@ func TestAddress_StableMarshal(t *testing.T) {
 		require.NoError(t, addressTo.Unmarshal(wire))
 
 		require.Equal(t, addressFrom, addressTo)
+
+		addressTo = new(refs.Address)
+		require.NoError(t, addressTo.StableUnmarshal(wire))
+		require.Equal(t, addressFrom, addressTo)
 	})
 }
 
@@ -86,6 +102,10 @@ func TestChecksum_StableMarshal(t *testing.T) {
 		require.NoError(t, checksumTo.Unmarshal(wire))
 
 		require.Equal(t, checksumFrom, checksumTo)
+
+		checksumTo = new(refs.Checksum)
+		require.NoError(t, checksumTo.StableUnmarshal(wire))
+		require.Equal(t, checksumFrom, checksumTo)
 	})
 }
 
@@ -100,6 +120,10 @@ func TestSignature_StableMarshal(t *testing.T) {
 		require.NoError(t, signatureTo.Unmarshal(wire))
 
 		require.Equal(t, signatureFrom, signatureTo)
+
+		signatureTo = new(refs.Signature)
+		require.NoError(t, signatureTo.StableUnmarshal(wire))
+		require.Equal(t, signatureFrom, signatureTo)
 	})
 }
 
@@ -114,6 +138,10 @@ func TestVersion_StableMarshal(t *testing.T) {
 		require.NoError(t, versionTo.Unmarshal(wire))
 
 		require.Equal(t, versionFrom, versionTo)
+
+		versionTo = new(refs.Version)
+		require.NoError(t, versionTo.StableUnmarshal(wire))
+		require.Equal(t, versionFrom, versionTo)
 	})
 }
 
@@ -0,0 +1,46 @@
+package refs
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"golang.org/x/crypto/ripemd160"
+)
+
+const (
+	// neo3AddressVersion is a version byte of NEO3 wallet address.
+	neo3AddressVersion = 0x35
+
+	// OwnerIDSize is a size of NEO3 wallet address in bytes.
+	OwnerIDSize = 1 + ripemd160.Size + 4
+)
+
+// NewOwnerIDFromPublicKey returns identifier of the owner of the public key.
+//
+// Owner identifier is a binary NEO3 wallet address of the standard
+// single-signature verification script of the key.
+func NewOwnerIDFromPublicKey(key *ecdsa.PublicKey) *OwnerID {
+	// PUSHDATA1 33 <compressed key> SYSCALL System.Crypto.CheckSig
+	script := make([]byte, 0, 40)
+	script = append(script, 0x0c, 0x21)
+	script = append(script, crypto.MarshalPublicKey(key)...)
+	script = append(script, 0x41, 0x56, 0xe7, 0xb3, 0x27)
+
+	sum := sha256.Sum256(script)
+
+	h := ripemd160.New()
+	_, _ = h.Write(sum[:])
+
+	addr := make([]byte, 0, OwnerIDSize)
+	addr = append(addr, neo3AddressVersion)
+	addr = h.Sum(addr)
+
+	sum = sha256.Sum256(addr)
+	sum = sha256.Sum256(sum[:])
+
+	id := new(OwnerID)
+	id.SetValue(append(addr, sum[:4]...))
+
+	return id
+}
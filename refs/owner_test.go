@@ -0,0 +1,39 @@
+package refs_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
+	"github.com/mr-tron/base58"
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOwnerIDFromPublicKey(t *testing.T) {
+	id := refs.NewOwnerIDFromPublicKey(&test.DecodeKey(0).PublicKey)
+	require.Len(t, id.GetValue(), refs.OwnerIDSize)
+	require.EqualValues(t, 0x35, id.GetValue()[0])
+
+	require.Equal(t, id, refs.NewOwnerIDFromPublicKey(&test.DecodeKey(0).PublicKey))
+	require.NotEqual(t, id, refs.NewOwnerIDFromPublicKey(&test.DecodeKey(1).PublicKey))
+}
+
+func TestNewOwnerIDFromPublicKey_KnownAnswer(t *testing.T) {
+	const (
+		pubHex = "02028a99826edc0c97d18e22b6932373d908d323aa7f92656a77ec26e8861699ef"
+		addr   = "NPTmAHDxo6Pkyic8Nvu3kwyXoYJCvcCB6i"
+		idHex  = "3526eba6592ddfb6b04426048cd5891ff0e3fecba764a021fb"
+	)
+
+	pubBytes, err := hex.DecodeString(pubHex)
+	require.NoError(t, err)
+
+	pub := crypto.UnmarshalPublicKey(pubBytes)
+	require.NotNil(t, pub)
+
+	id := refs.NewOwnerIDFromPublicKey(pub)
+	require.Equal(t, idHex, hex.EncodeToString(id.GetValue()))
+	require.Equal(t, addr, base58.Encode(id.GetValue()))
+}
@@ -0,0 +1,20 @@
+package refs
+
+const (
+	// APIVersionMajor is a major number of the API version
+	// implemented by the module.
+	APIVersionMajor = 2
+
+	// APIVersionMinor is a minor number of the API version
+	// implemented by the module.
+	APIVersionMinor = 0
+)
+
+// CurrentVersion returns API version implemented by the module.
+func CurrentVersion() *Version {
+	v := new(Version)
+	v.SetMajor(APIVersionMajor)
+	v.SetMinor(APIVersionMinor)
+
+	return v
+}
@@ -1,9 +1,9 @@
 package session
 
 import (
-	session "github.com/cthulhu-rider/neofs-api-go/v2/session/grpc"
+	"github.com/cthulhu-rider/neofs-api-go/v2/acl"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
 	"github.com/cthulhu-rider/neofs-api-go/v2/util/proto"
-	goproto "google.golang.org/protobuf/proto"
 )
 
 const (
@@ -97,6 +97,26 @@ func (c *CreateRequestBody) StableSize() (size int) {
 	return size
 }
 
+func (c *CreateRequestBody) StableUnmarshal(data []byte) error {
+	if c == nil {
+		return nil
+	}
+
+	*c = CreateRequestBody{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case createReqBodyOwnerField:
+			c.ownerID = new(refs.OwnerID)
+			err = f.Nested(c.ownerID)
+		case createReqBodyExpirationField:
+			c.expiration, err = f.UInt64()
+		}
+
+		return
+	})
+}
+
 func (c *CreateResponseBody) StableMarshal(buf []byte) ([]byte, error) {
 	if c == nil {
 		return []byte{}, nil
@@ -137,6 +157,25 @@ func (c *CreateResponseBody) StableSize() (size int) {
 	return size
 }
 
+func (c *CreateResponseBody) StableUnmarshal(data []byte) error {
+	if c == nil {
+		return nil
+	}
+
+	*c = CreateResponseBody{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case createRespBodyIDField:
+			c.id, err = f.Bytes()
+		case createRespBodyKeyField:
+			c.sessionKey, err = f.Bytes()
+		}
+
+		return
+	})
+}
+
 func (x *XHeader) StableMarshal(buf []byte) ([]byte, error) {
 	if x == nil {
 		return []byte{}, nil
@@ -177,15 +216,27 @@ func (x *XHeader) StableSize() (size int) {
 	return size
 }
 
-func (x *XHeader) Unmarshal(data []byte) error {
-	m := new(session.XHeader)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+func (x *XHeader) StableUnmarshal(data []byte) error {
+	if x == nil {
+		return nil
 	}
 
-	*x = *XHeaderFromGRPCMessage(m)
+	*x = XHeader{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case xheaderKeyField:
+			x.key, err = f.StringValue()
+		case xheaderValueField:
+			x.val, err = f.StringValue()
+		}
 
-	return nil
+		return
+	})
+}
+
+func (x *XHeader) Unmarshal(data []byte) error {
+	return x.StableUnmarshal(data)
 }
 
 func (l *TokenLifetime) StableMarshal(buf []byte) ([]byte, error) {
@@ -236,15 +287,29 @@ func (l *TokenLifetime) StableSize() (size int) {
 	return size
 }
 
-func (l *TokenLifetime) Unmarshal(data []byte) error {
-	m := new(session.SessionToken_Body_TokenLifetime)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+func (l *TokenLifetime) StableUnmarshal(data []byte) error {
+	if l == nil {
+		return nil
 	}
 
-	*l = *TokenLifetimeFromGRPCMessage(m)
+	*l = TokenLifetime{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case lifetimeExpirationField:
+			l.exp, err = f.UInt64()
+		case lifetimeNotValidBeforeField:
+			l.nbf, err = f.UInt64()
+		case lifetimeIssuedAtField:
+			l.iat, err = f.UInt64()
+		}
 
-	return nil
+		return
+	})
+}
+
+func (l *TokenLifetime) Unmarshal(data []byte) error {
+	return l.StableUnmarshal(data)
 }
 
 func (c *ObjectSessionContext) StableMarshal(buf []byte) ([]byte, error) {
@@ -287,15 +352,31 @@ func (c *ObjectSessionContext) StableSize() (size int) {
 	return size
 }
 
-func (c *ObjectSessionContext) Unmarshal(data []byte) error {
-	m := new(session.ObjectSessionContext)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+func (c *ObjectSessionContext) StableUnmarshal(data []byte) error {
+	if c == nil {
+		return nil
 	}
 
-	*c = *ObjectSessionContextFromGRPCMessage(m)
+	*c = ObjectSessionContext{}
 
-	return nil
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case objectCtxVerbField:
+			var v int32
+
+			v, err = f.Enum()
+			c.verb = ObjectSessionVerb(v)
+		case objectCtxAddressField:
+			c.addr = new(refs.Address)
+			err = f.Nested(c.addr)
+		}
+
+		return
+	})
+}
+
+func (c *ObjectSessionContext) Unmarshal(data []byte) error {
+	return c.StableUnmarshal(data)
 }
 
 func (t *SessionTokenBody) StableMarshal(buf []byte) ([]byte, error) {
@@ -377,15 +458,38 @@ func (t *SessionTokenBody) StableSize() (size int) {
 	return size
 }
 
-func (t *SessionTokenBody) Unmarshal(data []byte) error {
-	m := new(session.SessionToken_Body)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
-	}
+func (t *SessionTokenBody) StableUnmarshal(data []byte) error {
+	if t == nil {
+		return nil
+	}
+
+	*t = SessionTokenBody{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case sessionTokenBodyIDField:
+			t.id, err = f.Bytes()
+		case sessionTokenBodyOwnerField:
+			t.ownerID = new(refs.OwnerID)
+			err = f.Nested(t.ownerID)
+		case sessionTokenBodyLifetimeField:
+			t.lifetime = new(TokenLifetime)
+			err = f.Nested(t.lifetime)
+		case sessionTokenBodyKeyField:
+			t.sessionKey, err = f.Bytes()
+		case sessionTokenBodyObjectCtxField:
+			ctx := new(ObjectSessionContext)
+			t.ctx = ctx
+
+			err = f.Nested(ctx)
+		}
 
-	*t = *SessionTokenBodyFromGRPCMessage(m)
+		return
+	})
+}
 
-	return nil
+func (t *SessionTokenBody) Unmarshal(data []byte) error {
+	return t.StableUnmarshal(data)
 }
 
 func (t *SessionToken) StableMarshal(buf []byte) ([]byte, error) {
@@ -428,15 +532,29 @@ func (t *SessionToken) StableSize() (size int) {
 	return size
 }
 
-func (t *SessionToken) Unmarshal(data []byte) error {
-	m := new(session.SessionToken)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+func (t *SessionToken) StableUnmarshal(data []byte) error {
+	if t == nil {
+		return nil
 	}
 
-	*t = *SessionTokenFromGRPCMessage(m)
+	*t = SessionToken{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case sessionTokenBodyField:
+			t.body = new(SessionTokenBody)
+			err = f.Nested(t.body)
+		case sessionTokenSignatureField:
+			t.sig = new(refs.Signature)
+			err = f.Nested(t.sig)
+		}
 
-	return nil
+		return
+	})
+}
+
+func (t *SessionToken) Unmarshal(data []byte) error {
+	return t.StableUnmarshal(data)
 }
 
 func (r *RequestMetaHeader) StableMarshal(buf []byte) ([]byte, error) {
@@ -528,15 +646,44 @@ func (r *RequestMetaHeader) StableSize() (size int) {
 	return size
 }
 
-func (r *RequestMetaHeader) Unmarshal(data []byte) error {
-	m := new(session.RequestMetaHeader)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
-	}
+func (r *RequestMetaHeader) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = RequestMetaHeader{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case reqMetaHeaderVersionField:
+			r.version = new(refs.Version)
+			err = f.Nested(r.version)
+		case reqMetaHeaderEpochField:
+			r.epoch, err = f.UInt64()
+		case reqMetaHeaderTTLField:
+			r.ttl, err = f.UInt32()
+		case reqMetaHeaderXHeadersField:
+			v := new(XHeader)
+			r.xHeaders = append(r.xHeaders, v)
+
+			err = f.Nested(v)
+		case reqMetaHeaderSessionTokenField:
+			r.sessionToken = new(SessionToken)
+			err = f.Nested(r.sessionToken)
+		case reqMetaHeaderBearerTokenField:
+			r.bearerToken = new(acl.BearerToken)
+			err = f.Nested(r.bearerToken)
+		case reqMetaHeaderOriginField:
+			r.origin = new(RequestMetaHeader)
+			err = f.Nested(r.origin)
+		}
 
-	*r = *RequestMetaHeaderFromGRPCMessage(m)
+		return
+	})
+}
 
-	return nil
+func (r *RequestMetaHeader) Unmarshal(data []byte) error {
+	return r.StableUnmarshal(data)
 }
 
 func (r *RequestVerificationHeader) StableMarshal(buf []byte) ([]byte, error) {
@@ -595,15 +742,35 @@ func (r *RequestVerificationHeader) StableSize() (size int) {
 	return size
 }
 
-func (r *RequestVerificationHeader) Unmarshal(data []byte) error {
-	m := new(session.RequestVerificationHeader)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
-	}
+func (r *RequestVerificationHeader) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = RequestVerificationHeader{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case reqVerifHeaderBodySignatureField:
+			r.bodySig = new(refs.Signature)
+			err = f.Nested(r.bodySig)
+		case reqVerifHeaderMetaSignatureField:
+			r.metaSig = new(refs.Signature)
+			err = f.Nested(r.metaSig)
+		case reqVerifHeaderOriginSignatureField:
+			r.originSig = new(refs.Signature)
+			err = f.Nested(r.originSig)
+		case reqVerifHeaderOriginField:
+			r.origin = new(RequestVerificationHeader)
+			err = f.Nested(r.origin)
+		}
 
-	*r = *RequestVerificationHeaderFromGRPCMessage(m)
+		return
+	})
+}
 
-	return nil
+func (r *RequestVerificationHeader) Unmarshal(data []byte) error {
+	return r.StableUnmarshal(data)
 }
 
 func (r *ResponseMetaHeader) StableMarshal(buf []byte) ([]byte, error) {
@@ -679,15 +846,38 @@ func (r *ResponseMetaHeader) StableSize() (size int) {
 	return size
 }
 
-func (r *ResponseMetaHeader) Unmarshal(data []byte) error {
-	m := new(session.ResponseMetaHeader)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
-	}
+func (r *ResponseMetaHeader) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = ResponseMetaHeader{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case respMetaHeaderVersionField:
+			r.version = new(refs.Version)
+			err = f.Nested(r.version)
+		case respMetaHeaderEpochField:
+			r.epoch, err = f.UInt64()
+		case respMetaHeaderTTLField:
+			r.ttl, err = f.UInt32()
+		case respMetaHeaderXHeadersField:
+			v := new(XHeader)
+			r.xHeaders = append(r.xHeaders, v)
+
+			err = f.Nested(v)
+		case respMetaHeaderOriginField:
+			r.origin = new(ResponseMetaHeader)
+			err = f.Nested(r.origin)
+		}
 
-	*r = *ResponseMetaHeaderFromGRPCMessage(m)
+		return
+	})
+}
 
-	return nil
+func (r *ResponseMetaHeader) Unmarshal(data []byte) error {
+	return r.StableUnmarshal(data)
 }
 
 func (r *ResponseVerificationHeader) StableMarshal(buf []byte) ([]byte, error) {
@@ -746,13 +936,33 @@ func (r *ResponseVerificationHeader) StableSize() (size int) {
 	return size
 }
 
-func (r *ResponseVerificationHeader) Unmarshal(data []byte) error {
-	m := new(session.ResponseVerificationHeader)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
-	}
+func (r *ResponseVerificationHeader) StableUnmarshal(data []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	*r = ResponseVerificationHeader{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case respVerifHeaderBodySignatureField:
+			r.bodySig = new(refs.Signature)
+			err = f.Nested(r.bodySig)
+		case respVerifHeaderMetaSignatureField:
+			r.metaSig = new(refs.Signature)
+			err = f.Nested(r.metaSig)
+		case respVerifHeaderOriginSignatureField:
+			r.originSig = new(refs.Signature)
+			err = f.Nested(r.originSig)
+		case respVerifHeaderOriginField:
+			r.origin = new(ResponseVerificationHeader)
+			err = f.Nested(r.origin)
+		}
 
-	*r = *ResponseVerificationHeaderFromGRPCMessage(m)
+		return
+	})
+}
 
-	return nil
+func (r *ResponseVerificationHeader) Unmarshal(data []byte) error {
+	return r.StableUnmarshal(data)
 }
@@ -25,6 +25,10 @@ func TestCreateRequestBody_StableMarshal(t *testing.T) {
 
 		requestTo := session.CreateRequestBodyFromGRPCMessage(transport)
 		require.Equal(t, requestFrom, requestTo)
+
+		requestTo = new(session.CreateRequestBody)
+		require.NoError(t, requestTo.StableUnmarshal(wire))
+		require.Equal(t, requestFrom, requestTo)
 	})
 }
 
@@ -41,6 +45,10 @@ func TestCreateResponseBody_StableMarshal(t *testing.T) {
 
 		responseTo := session.CreateResponseBodyFromGRPCMessage(transport)
 		require.Equal(t, responseFrom, responseTo)
+
+		responseTo = new(session.CreateResponseBody)
+		require.NoError(t, responseTo.StableUnmarshal(wire))
+		require.Equal(t, responseFrom, responseTo)
 	})
 }
 
@@ -55,6 +63,10 @@ func TestXHeader_StableMarshal(t *testing.T) {
 		require.NoError(t, xheaderTo.Unmarshal(wire))
 
 		require.Equal(t, xheaderFrom, xheaderTo)
+
+		xheaderTo = new(session.XHeader)
+		require.NoError(t, xheaderTo.StableUnmarshal(wire))
+		require.Equal(t, xheaderFrom, xheaderTo)
 	})
 }
 
@@ -69,6 +81,10 @@ func TestTokenLifetime_StableMarshal(t *testing.T) {
 		require.NoError(t, lifetimeTo.Unmarshal(wire))
 
 		require.Equal(t, lifetimeFrom, lifetimeTo)
+
+		lifetimeTo = new(session.TokenLifetime)
+		require.NoError(t, lifetimeTo.StableUnmarshal(wire))
+		require.Equal(t, lifetimeFrom, lifetimeTo)
 	})
 }
 
@@ -83,6 +99,10 @@ func TestObjectSessionContext_StableMarshal(t *testing.T) {
 		require.NoError(t, objectCtxTo.Unmarshal(wire))
 
 		require.Equal(t, objectCtxFrom, objectCtxTo)
+
+		objectCtxTo = new(session.ObjectSessionContext)
+		require.NoError(t, objectCtxTo.StableUnmarshal(wire))
+		require.Equal(t, objectCtxFrom, objectCtxTo)
 	})
 }
 
@@ -97,6 +117,10 @@ func TestSessionTokenBody_StableMarshal(t *testing.T) {
 		require.NoError(t, sessionTokenBodyTo.Unmarshal(wire))
 
 		require.Equal(t, sessionTokenBodyFrom, sessionTokenBodyTo)
+
+		sessionTokenBodyTo = new(session.SessionTokenBody)
+		require.NoError(t, sessionTokenBodyTo.StableUnmarshal(wire))
+		require.Equal(t, sessionTokenBodyFrom, sessionTokenBodyTo)
 	})
 }
 
@@ -111,6 +135,10 @@ func TestSessionToken_StableMarshal(t *testing.T) {
 		require.NoError(t, sessionTokenTo.Unmarshal(wire))
 
 		require.Equal(t, sessionTokenFrom, sessionTokenTo)
+
+		sessionTokenTo = new(session.SessionToken)
+		require.NoError(t, sessionTokenTo.StableUnmarshal(wire))
+		require.Equal(t, sessionTokenFrom, sessionTokenTo)
 	})
 }
 
@@ -127,6 +155,10 @@ func TestRequestMetaHeader_StableMarshal(t *testing.T) {
 		require.NoError(t, metaHeaderTo.Unmarshal(wire))
 
 		require.Equal(t, metaHeaderFrom, metaHeaderTo)
+
+		metaHeaderTo = new(session.RequestMetaHeader)
+		require.NoError(t, metaHeaderTo.StableUnmarshal(wire))
+		require.Equal(t, metaHeaderFrom, metaHeaderTo)
 	})
 }
 
@@ -143,6 +175,10 @@ func TestRequestVerificationHeader_StableMarshal(t *testing.T) {
 		require.NoError(t, verifHeaderTo.Unmarshal(wire))
 
 		require.Equal(t, verifHeaderFrom, verifHeaderTo)
+
+		verifHeaderTo = new(session.RequestVerificationHeader)
+		require.NoError(t, verifHeaderTo.StableUnmarshal(wire))
+		require.Equal(t, verifHeaderFrom, verifHeaderTo)
 	})
 }
 
@@ -159,6 +195,10 @@ func TestResponseMetaHeader_StableMarshal(t *testing.T) {
 		require.NoError(t, metaHeaderTo.Unmarshal(wire))
 
 		require.Equal(t, metaHeaderFrom, metaHeaderTo)
+
+		metaHeaderTo = new(session.ResponseMetaHeader)
+		require.NoError(t, metaHeaderTo.StableUnmarshal(wire))
+		require.Equal(t, metaHeaderFrom, metaHeaderTo)
 	})
 }
 
@@ -175,6 +215,10 @@ func TestResponseVerificationHeader_StableMarshal(t *testing.T) {
 		require.NoError(t, verifHeaderTo.Unmarshal(wire))
 
 		require.Equal(t, verifHeaderFrom, verifHeaderTo)
+
+		verifHeaderTo = new(session.ResponseVerificationHeader)
+		require.NoError(t, verifHeaderTo.StableUnmarshal(wire))
+		require.Equal(t, verifHeaderFrom, verifHeaderTo)
 	})
 }
 
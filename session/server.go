@@ -0,0 +1,35 @@
+package session
+
+import (
+	"context"
+
+	session "github.com/cthulhu-rider/neofs-api-go/v2/session/grpc"
+	"google.golang.org/grpc"
+)
+
+type grpcServer struct {
+	svc Service
+}
+
+// NewGRPCServer returns gRPC server of the session service
+// which handles requests with svc.
+func NewGRPCServer(svc Service) session.SessionServiceServer {
+	return &grpcServer{
+		svc: svc,
+	}
+}
+
+// RegisterGRPCServer registers gRPC server of the session service
+// which handles requests with svc on gs.
+func RegisterGRPCServer(gs *grpc.Server, svc Service) {
+	session.RegisterSessionServiceServer(gs, NewGRPCServer(svc))
+}
+
+func (s *grpcServer) Create(ctx context.Context, req *session.CreateRequest) (*session.CreateResponse, error) {
+	resp, err := s.svc.Create(ctx, CreateRequestFromGRPCMessage(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return CreateResponseToGRPCMessage(resp), nil
+}
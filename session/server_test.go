@@ -0,0 +1,64 @@
+package session_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/client"
+	"github.com/cthulhu-rider/neofs-api-go/v2/internal/grpctest"
+	"github.com/cthulhu-rider/neofs-api-go/v2/session"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testService struct {
+	err error
+
+	body *session.CreateRequestBody
+}
+
+func (s *testService) Create(_ context.Context, req *session.CreateRequest) (*session.CreateResponse, error) {
+	s.body = req.GetBody()
+
+	resp := new(session.CreateResponse)
+	resp.SetBody(generateCreateSessionResponseBody("id", "key"))
+
+	return resp, s.err
+}
+
+func TestGRPCServer(t *testing.T) {
+	svc := new(testService)
+
+	conn := grpctest.Dial(t, func(gs *grpc.Server) {
+		session.RegisterGRPCServer(gs, svc)
+	})
+
+	c, err := session.NewClient(session.WithGlobalOpts(client.WithGRPCConn(conn)))
+	require.NoError(t, err)
+
+	req := new(session.CreateRequest)
+	req.SetBody(generateCreateSessionRequestBody("owner"))
+
+	t.Run("create", func(t *testing.T) {
+		resp, err := c.Create(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, req.GetBody(), svc.body)
+		require.Equal(t, generateCreateSessionResponseBody("id", "key"), resp.GetBody())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		svc.err = status.Error(codes.ResourceExhausted, "too many sessions")
+		defer func() { svc.err = nil }()
+
+		_, err := c.Create(context.Background(), req)
+		require.Error(t, err)
+
+		st, ok := status.FromError(errors.Cause(err))
+		require.True(t, ok)
+		require.Equal(t, codes.ResourceExhausted, st.Code())
+		require.Equal(t, "too many sessions", st.Message())
+	})
+}
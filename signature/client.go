@@ -0,0 +1,479 @@
+package signature
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/accounting"
+	"github.com/cthulhu-rider/neofs-api-go/v2/container"
+	"github.com/cthulhu-rider/neofs-api-go/v2/netmap"
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/session"
+)
+
+type accountingClient struct {
+	svc accounting.Service
+
+	*cfg
+}
+
+// WrapAccountingClient returns accounting service which signs requests
+// with the key before passing them to svc and verifies responses.
+//
+// Responses with invalid signatures are rejected with *VerificationError.
+func WrapAccountingClient(svc accounting.Service, key *ecdsa.PrivateKey, opts ...Option) accounting.Service {
+	return &accountingClient{
+		svc: svc,
+		cfg: newCfg(key, opts...),
+	}
+}
+
+func (s *accountingClient) Balance(ctx context.Context, req *accounting.BalanceRequest) (*accounting.BalanceResponse, error) {
+	if err := s.sign(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.Balance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type containerClient struct {
+	svc container.Service
+
+	*cfg
+}
+
+// WrapContainerClient returns container service which signs requests
+// with the key before passing them to svc and verifies responses.
+//
+// Responses with invalid signatures are rejected with *VerificationError.
+func WrapContainerClient(svc container.Service, key *ecdsa.PrivateKey, opts ...Option) container.Service {
+	return &containerClient{
+		svc: svc,
+		cfg: newCfg(key, opts...),
+	}
+}
+
+func (s *containerClient) Put(ctx context.Context, req *container.PutRequest) (*container.PutResponse, error) {
+	if err := s.sign(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.Put(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *containerClient) Delete(ctx context.Context, req *container.DeleteRequest) (*container.DeleteResponse, error) {
+	if err := s.sign(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.Delete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *containerClient) Get(ctx context.Context, req *container.GetRequest) (*container.GetResponse, error) {
+	if err := s.sign(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *containerClient) List(ctx context.Context, req *container.ListRequest) (*container.ListResponse, error) {
+	if err := s.sign(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *containerClient) SetExtendedACL(ctx context.Context, req *container.SetExtendedACLRequest) (*container.SetExtendedACLResponse, error) {
+	if err := s.sign(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.SetExtendedACL(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *containerClient) GetExtendedACL(ctx context.Context, req *container.GetExtendedACLRequest) (*container.GetExtendedACLResponse, error) {
+	if err := s.sign(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.GetExtendedACL(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *containerClient) AnnounceUsedSpace(ctx context.Context, req *container.AnnounceUsedSpaceRequest) (*container.AnnounceUsedSpaceResponse, error) {
+	if err := s.sign(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.AnnounceUsedSpace(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type netmapClient struct {
+	svc netmap.Service
+
+	*cfg
+}
+
+// WrapNetmapClient returns netmap service which signs requests
+// with the key before passing them to svc and verifies responses.
+//
+// Responses with invalid signatures are rejected with *VerificationError.
+func WrapNetmapClient(svc netmap.Service, key *ecdsa.PrivateKey, opts ...Option) netmap.Service {
+	return &netmapClient{
+		svc: svc,
+		cfg: newCfg(key, opts...),
+	}
+}
+
+func (s *netmapClient) LocalNodeInfo(ctx context.Context, req *netmap.LocalNodeInfoRequest) (*netmap.LocalNodeInfoResponse, error) {
+	if err := s.sign(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.LocalNodeInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *netmapClient) NetworkInfo(ctx context.Context, req *netmap.NetworkInfoRequest) (*netmap.NetworkInfoResponse, error) {
+	if err := s.sign(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.NetworkInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type objectClient struct {
+	svc object.Service
+
+	*cfg
+}
+
+// WrapObjectClient returns object service which signs requests
+// with the key before passing them to svc and verifies responses.
+//
+// Responses with invalid signatures are rejected with *VerificationError.
+func WrapObjectClient(svc object.Service, key *ecdsa.PrivateKey, opts ...Option) object.Service {
+	return &objectClient{
+		svc: svc,
+		cfg: newCfg(key, opts...),
+	}
+}
+
+func (s *objectClient) Head(ctx context.Context, req *object.HeadRequest) (*object.HeadResponse, error) {
+	if err := s.sign(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.Head(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *objectClient) Delete(ctx context.Context, req *object.DeleteRequest) (*object.DeleteResponse, error) {
+	if err := s.sign(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.Delete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *objectClient) GetRangeHash(ctx context.Context, req *object.GetRangeHashRequest) (*object.GetRangeHashResponse, error) {
+	if err := s.sign(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.GetRangeHash(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *objectClient) Get(ctx context.Context, req *object.GetRequest) (object.GetObjectStreamer, error) {
+	if err := s.sign(req); err != nil {
+		return nil, err
+	}
+
+	stream, err := s.svc.Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &getClientStream{
+		stream: stream,
+		cfg:    s.cfg,
+	}, nil
+}
+
+func (s *objectClient) Search(ctx context.Context, req *object.SearchRequest) (object.SearchObjectStreamer, error) {
+	if err := s.sign(req); err != nil {
+		return nil, err
+	}
+
+	stream, err := s.svc.Search(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &searchClientStream{
+		stream: stream,
+		cfg:    s.cfg,
+	}, nil
+}
+
+func (s *objectClient) GetRange(ctx context.Context, req *object.GetRangeRequest) (object.GetRangeObjectStreamer, error) {
+	if err := s.sign(req); err != nil {
+		return nil, err
+	}
+
+	stream, err := s.svc.GetRange(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &getRangeClientStream{
+		stream: stream,
+		cfg:    s.cfg,
+	}, nil
+}
+
+func (s *objectClient) Put(ctx context.Context) (object.PutObjectStreamer, error) {
+	stream, err := s.svc.Put(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &putClientStream{
+		stream: stream,
+		cfg:    s.cfg,
+	}, nil
+}
+
+type getClientStream struct {
+	stream object.GetObjectStreamer
+
+	*cfg
+}
+
+func (s *getClientStream) Recv() (*object.GetResponse, error) {
+	resp, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type searchClientStream struct {
+	stream object.SearchObjectStreamer
+
+	*cfg
+}
+
+func (s *searchClientStream) Recv() (*object.SearchResponse, error) {
+	resp, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type getRangeClientStream struct {
+	stream object.GetRangeObjectStreamer
+
+	*cfg
+}
+
+func (s *getRangeClientStream) Recv() (*object.GetRangeResponse, error) {
+	resp, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type putClientStream struct {
+	stream object.PutObjectStreamer
+
+	*cfg
+}
+
+func (s *putClientStream) Send(req *object.PutRequest) error {
+	if err := s.sign(req); err != nil {
+		return err
+	}
+
+	return s.stream.Send(req)
+}
+
+func (s *putClientStream) CloseAndRecv() (*object.PutResponse, error) {
+	resp, err := s.stream.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type sessionClient struct {
+	svc session.Service
+
+	*cfg
+}
+
+// WrapSessionClient returns session service which signs requests
+// with the key before passing them to svc and verifies responses.
+//
+// Responses with invalid signatures are rejected with *VerificationError.
+func WrapSessionClient(svc session.Service, key *ecdsa.PrivateKey, opts ...Option) session.Service {
+	return &sessionClient{
+		svc: svc,
+		cfg: newCfg(key, opts...),
+	}
+}
+
+func (s *sessionClient) Create(ctx context.Context, req *session.CreateRequest) (*session.CreateResponse, error) {
+	if err := s.sign(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verify(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
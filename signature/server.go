@@ -0,0 +1,479 @@
+package signature
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/accounting"
+	"github.com/cthulhu-rider/neofs-api-go/v2/container"
+	"github.com/cthulhu-rider/neofs-api-go/v2/netmap"
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/session"
+)
+
+type accountingServer struct {
+	svc accounting.Service
+
+	*cfg
+}
+
+// WrapAccountingServer returns accounting service which verifies requests
+// and signs responses with the key before passing them to svc.
+//
+// Requests with invalid signatures are rejected with *VerificationError.
+func WrapAccountingServer(svc accounting.Service, key *ecdsa.PrivateKey, opts ...Option) accounting.Service {
+	return &accountingServer{
+		svc: svc,
+		cfg: newCfg(key, opts...),
+	}
+}
+
+func (s *accountingServer) Balance(ctx context.Context, req *accounting.BalanceRequest) (*accounting.BalanceResponse, error) {
+	if err := s.verify(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.Balance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type containerServer struct {
+	svc container.Service
+
+	*cfg
+}
+
+// WrapContainerServer returns container service which verifies requests
+// and signs responses with the key before passing them to svc.
+//
+// Requests with invalid signatures are rejected with *VerificationError.
+func WrapContainerServer(svc container.Service, key *ecdsa.PrivateKey, opts ...Option) container.Service {
+	return &containerServer{
+		svc: svc,
+		cfg: newCfg(key, opts...),
+	}
+}
+
+func (s *containerServer) Put(ctx context.Context, req *container.PutRequest) (*container.PutResponse, error) {
+	if err := s.verify(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.Put(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *containerServer) Delete(ctx context.Context, req *container.DeleteRequest) (*container.DeleteResponse, error) {
+	if err := s.verify(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.Delete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *containerServer) Get(ctx context.Context, req *container.GetRequest) (*container.GetResponse, error) {
+	if err := s.verify(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *containerServer) List(ctx context.Context, req *container.ListRequest) (*container.ListResponse, error) {
+	if err := s.verify(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *containerServer) SetExtendedACL(ctx context.Context, req *container.SetExtendedACLRequest) (*container.SetExtendedACLResponse, error) {
+	if err := s.verify(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.SetExtendedACL(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *containerServer) GetExtendedACL(ctx context.Context, req *container.GetExtendedACLRequest) (*container.GetExtendedACLResponse, error) {
+	if err := s.verify(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.GetExtendedACL(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *containerServer) AnnounceUsedSpace(ctx context.Context, req *container.AnnounceUsedSpaceRequest) (*container.AnnounceUsedSpaceResponse, error) {
+	if err := s.verify(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.AnnounceUsedSpace(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type netmapServer struct {
+	svc netmap.Service
+
+	*cfg
+}
+
+// WrapNetmapServer returns netmap service which verifies requests
+// and signs responses with the key before passing them to svc.
+//
+// Requests with invalid signatures are rejected with *VerificationError.
+func WrapNetmapServer(svc netmap.Service, key *ecdsa.PrivateKey, opts ...Option) netmap.Service {
+	return &netmapServer{
+		svc: svc,
+		cfg: newCfg(key, opts...),
+	}
+}
+
+func (s *netmapServer) LocalNodeInfo(ctx context.Context, req *netmap.LocalNodeInfoRequest) (*netmap.LocalNodeInfoResponse, error) {
+	if err := s.verify(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.LocalNodeInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *netmapServer) NetworkInfo(ctx context.Context, req *netmap.NetworkInfoRequest) (*netmap.NetworkInfoResponse, error) {
+	if err := s.verify(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.NetworkInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type objectServer struct {
+	svc object.Service
+
+	*cfg
+}
+
+// WrapObjectServer returns object service which verifies requests
+// and signs responses with the key before passing them to svc.
+//
+// Requests with invalid signatures are rejected with *VerificationError.
+func WrapObjectServer(svc object.Service, key *ecdsa.PrivateKey, opts ...Option) object.Service {
+	return &objectServer{
+		svc: svc,
+		cfg: newCfg(key, opts...),
+	}
+}
+
+func (s *objectServer) Head(ctx context.Context, req *object.HeadRequest) (*object.HeadResponse, error) {
+	if err := s.verify(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.Head(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *objectServer) Delete(ctx context.Context, req *object.DeleteRequest) (*object.DeleteResponse, error) {
+	if err := s.verify(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.Delete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *objectServer) GetRangeHash(ctx context.Context, req *object.GetRangeHashRequest) (*object.GetRangeHashResponse, error) {
+	if err := s.verify(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.GetRangeHash(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *objectServer) Get(ctx context.Context, req *object.GetRequest) (object.GetObjectStreamer, error) {
+	if err := s.verify(req); err != nil {
+		return nil, err
+	}
+
+	stream, err := s.svc.Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &getServerStream{
+		stream: stream,
+		cfg:    s.cfg,
+	}, nil
+}
+
+func (s *objectServer) Search(ctx context.Context, req *object.SearchRequest) (object.SearchObjectStreamer, error) {
+	if err := s.verify(req); err != nil {
+		return nil, err
+	}
+
+	stream, err := s.svc.Search(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &searchServerStream{
+		stream: stream,
+		cfg:    s.cfg,
+	}, nil
+}
+
+func (s *objectServer) GetRange(ctx context.Context, req *object.GetRangeRequest) (object.GetRangeObjectStreamer, error) {
+	if err := s.verify(req); err != nil {
+		return nil, err
+	}
+
+	stream, err := s.svc.GetRange(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &getRangeServerStream{
+		stream: stream,
+		cfg:    s.cfg,
+	}, nil
+}
+
+func (s *objectServer) Put(ctx context.Context) (object.PutObjectStreamer, error) {
+	stream, err := s.svc.Put(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &putServerStream{
+		stream: stream,
+		cfg:    s.cfg,
+	}, nil
+}
+
+type getServerStream struct {
+	stream object.GetObjectStreamer
+
+	*cfg
+}
+
+func (s *getServerStream) Recv() (*object.GetResponse, error) {
+	resp, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type searchServerStream struct {
+	stream object.SearchObjectStreamer
+
+	*cfg
+}
+
+func (s *searchServerStream) Recv() (*object.SearchResponse, error) {
+	resp, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type getRangeServerStream struct {
+	stream object.GetRangeObjectStreamer
+
+	*cfg
+}
+
+func (s *getRangeServerStream) Recv() (*object.GetRangeResponse, error) {
+	resp, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type putServerStream struct {
+	stream object.PutObjectStreamer
+
+	*cfg
+}
+
+func (s *putServerStream) Send(req *object.PutRequest) error {
+	if err := s.verify(req); err != nil {
+		return err
+	}
+
+	return s.stream.Send(req)
+}
+
+func (s *putServerStream) CloseAndRecv() (*object.PutResponse, error) {
+	resp, err := s.stream.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type sessionServer struct {
+	svc session.Service
+
+	*cfg
+}
+
+// WrapSessionServer returns session service which verifies requests
+// and signs responses with the key before passing them to svc.
+//
+// Requests with invalid signatures are rejected with *VerificationError.
+func WrapSessionServer(svc session.Service, key *ecdsa.PrivateKey, opts ...Option) session.Service {
+	return &sessionServer{
+		svc: svc,
+		cfg: newCfg(key, opts...),
+	}
+}
+
+func (s *sessionServer) Create(ctx context.Context, req *session.CreateRequest) (*session.CreateResponse, error) {
+	if err := s.verify(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.svc.Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sign(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
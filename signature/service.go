@@ -0,0 +1,75 @@
+package signature
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/signature"
+	"github.com/pkg/errors"
+)
+
+// Option is a configuration option of the service wrappers.
+type Option func(*cfg)
+
+type cfg struct {
+	key *ecdsa.PrivateKey
+
+	signOpts []signature.SignOption
+}
+
+// VerificationError is returned by the service wrappers when
+// the signatures of the received message are invalid.
+type VerificationError struct {
+	cause error
+}
+
+func (e *VerificationError) Error() string {
+	return "message verification failed: " + e.cause.Error()
+}
+
+// Cause returns the reason of the verification failure.
+func (e *VerificationError) Cause() error {
+	return e.cause
+}
+
+// Unwrap returns the reason of the verification failure.
+func (e *VerificationError) Unwrap() error {
+	return e.cause
+}
+
+func newCfg(key *ecdsa.PrivateKey, opts ...Option) *cfg {
+	c := &cfg{
+		key: key,
+	}
+
+	for i := range opts {
+		opts[i](c)
+	}
+
+	return c
+}
+
+// WithSignOptions returns option to sign and verify messages
+// with the signature options.
+func WithSignOptions(v ...signature.SignOption) Option {
+	return func(c *cfg) {
+		c.signOpts = v
+	}
+}
+
+func (c *cfg) sign(msg interface{}) error {
+	if err := SignServiceMessage(c.key, msg, c.signOpts...); err != nil {
+		return errors.Wrap(err, "could not sign message")
+	}
+
+	return nil
+}
+
+func (c *cfg) verify(msg interface{}) error {
+	if err := VerifyServiceMessage(msg, c.signOpts...); err != nil {
+		return &VerificationError{
+			cause: err,
+		}
+	}
+
+	return nil
+}
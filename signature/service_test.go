@@ -0,0 +1,132 @@
+package signature
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/accounting"
+	"github.com/cthulhu-rider/neofs-api-go/v2/object"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/signature"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type testAccountingService struct {
+	balance int64
+}
+
+type testObjectService struct {
+	object.Service
+
+	chunks [][]byte
+}
+
+type testGetStream struct {
+	chunks [][]byte
+}
+
+func (s *testAccountingService) Balance(context.Context, *accounting.BalanceRequest) (*accounting.BalanceResponse, error) {
+	dec := new(accounting.Decimal)
+	dec.SetValue(s.balance)
+
+	body := new(accounting.BalanceResponseBody)
+	body.SetBalance(dec)
+
+	resp := new(accounting.BalanceResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
+
+func (s *testObjectService) Get(context.Context, *object.GetRequest) (object.GetObjectStreamer, error) {
+	return &testGetStream{
+		chunks: s.chunks,
+	}, nil
+}
+
+func (s *testGetStream) Recv() (*object.GetResponse, error) {
+	if len(s.chunks) == 0 {
+		return nil, io.EOF
+	}
+
+	chunk := new(object.GetObjectPartChunk)
+	chunk.SetChunk(s.chunks[0])
+	s.chunks = s.chunks[1:]
+
+	body := new(object.GetResponseBody)
+	body.SetObjectPart(chunk)
+
+	resp := new(object.GetResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
+
+func balanceRequest() *accounting.BalanceRequest {
+	req := new(accounting.BalanceRequest)
+	req.SetBody(new(accounting.BalanceRequestBody))
+
+	return req
+}
+
+func TestServiceWrappers(t *testing.T) {
+	opts := []Option{WithSignOptions(signature.SignWithRFC6979())}
+
+	ctx := context.Background()
+
+	t.Run("unary", func(t *testing.T) {
+		srv := WrapAccountingServer(&testAccountingService{balance: 10}, test.DecodeKey(0), opts...)
+		cli := WrapAccountingClient(srv, test.DecodeKey(1), opts...)
+
+		resp, err := cli.Balance(ctx, balanceRequest())
+		require.NoError(t, err)
+		require.EqualValues(t, 10, resp.GetBody().GetBalance().GetValue())
+		require.NoError(t, VerifyServiceMessage(resp, signature.SignWithRFC6979()))
+
+		// unsigned request
+		_, err = srv.Balance(ctx, balanceRequest())
+
+		var e *VerificationError
+		require.True(t, errors.As(err, &e))
+
+		// unsigned response
+		cli = WrapAccountingClient(&testAccountingService{}, test.DecodeKey(1), opts...)
+
+		_, err = cli.Balance(ctx, balanceRequest())
+		require.True(t, errors.As(err, &e))
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		chunks := [][]byte{{1}, {2, 3}}
+
+		srv := WrapObjectServer(&testObjectService{chunks: chunks}, test.DecodeKey(0), opts...)
+		cli := WrapObjectClient(srv, test.DecodeKey(1), opts...)
+
+		req := new(object.GetRequest)
+		req.SetBody(new(object.GetRequestBody))
+
+		stream, err := cli.Get(ctx, req)
+		require.NoError(t, err)
+
+		for i := range chunks {
+			resp, err := stream.Recv()
+			require.NoError(t, err)
+			require.Equal(t, chunks[i], resp.GetBody().GetObjectPart().(*object.GetObjectPartChunk).GetChunk())
+		}
+
+		_, err = stream.Recv()
+		require.Equal(t, io.EOF, err)
+
+		cli = WrapObjectClient(&testObjectService{chunks: chunks}, test.DecodeKey(1), opts...)
+
+		stream, err = cli.Get(ctx, req)
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+
+		var e *VerificationError
+		require.True(t, errors.As(err, &e))
+	})
+}
@@ -31,9 +31,7 @@ type stableMarshaler interface {
 	StableSize() int
 }
 
-type StableMarshalerWrapper struct {
-	SM stableMarshaler
-}
+type StableMarshalerWrapper = signature.StableMarshalerWrapper
 
 type metaHeader interface {
 	stableMarshaler
@@ -114,22 +112,6 @@ func (r *responseVerificationHeader) setOrigin(m stableMarshaler) {
 	}
 }
 
-func (s StableMarshalerWrapper) ReadSignedData(buf []byte) ([]byte, error) {
-	if s.SM != nil {
-		return s.SM.StableMarshal(buf)
-	}
-
-	return nil, nil
-}
-
-func (s StableMarshalerWrapper) SignedDataSize() int {
-	if s.SM != nil {
-		return s.SM.StableSize()
-	}
-
-	return 0
-}
-
 func keySignatureHandler(s *refs.Signature) signature.KeySignatureHandler {
 	return func(key []byte, sig []byte) {
 		s.SetKey(key)
@@ -143,7 +125,7 @@ func keySignatureSource(s *refs.Signature) signature.KeySignatureSource {
 	}
 }
 
-func SignServiceMessage(key *ecdsa.PrivateKey, msg interface{}) error {
+func SignServiceMessage(key *ecdsa.PrivateKey, msg interface{}, opts ...signature.SignOption) error {
 	var (
 		body, meta, verifyOrigin stableMarshaler
 		verifyHdr                verificationHeader
@@ -181,18 +163,18 @@ func SignServiceMessage(key *ecdsa.PrivateKey, msg interface{}) error {
 
 	if verifyOrigin == nil {
 		// sign session message body
-		if err := signServiceMessagePart(key, body, verifyHdr.SetBodySignature); err != nil {
+		if err := signServiceMessagePart(key, body, verifyHdr.SetBodySignature, opts...); err != nil {
 			return errors.Wrap(err, "could not sign body")
 		}
 	}
 
 	// sign meta header
-	if err := signServiceMessagePart(key, meta, verifyHdr.SetMetaSignature); err != nil {
+	if err := signServiceMessagePart(key, meta, verifyHdr.SetMetaSignature, opts...); err != nil {
 		return errors.Wrap(err, "could not sign meta header")
 	}
 
 	// sign verification header origin
-	if err := signServiceMessagePart(key, verifyOrigin, verifyHdr.SetOriginSignature); err != nil {
+	if err := signServiceMessagePart(key, verifyOrigin, verifyHdr.SetOriginSignature, opts...); err != nil {
 		return errors.Wrap(err, "could not sign origin of verification header")
 	}
 
@@ -205,14 +187,15 @@ func SignServiceMessage(key *ecdsa.PrivateKey, msg interface{}) error {
 	return nil
 }
 
-func signServiceMessagePart(key *ecdsa.PrivateKey, part stableMarshaler, sigWrite func(*refs.Signature)) error {
+func signServiceMessagePart(key *ecdsa.PrivateKey, part stableMarshaler, sigWrite func(*refs.Signature), opts ...signature.SignOption) error {
 	sig := new(refs.Signature)
 
 	// sign part
 	if err := signature.SignDataWithHandler(
 		key,
-		&StableMarshalerWrapper{part},
+		&StableMarshalerWrapper{SM: part},
 		keySignatureHandler(sig),
+		opts...,
 	); err != nil {
 		return err
 	}
@@ -223,7 +206,7 @@ func signServiceMessagePart(key *ecdsa.PrivateKey, part stableMarshaler, sigWrit
 	return nil
 }
 
-func VerifyServiceMessage(msg interface{}) error {
+func VerifyServiceMessage(msg interface{}, opts ...signature.SignOption) error {
 	var (
 		meta   metaHeader
 		verify verificationHeader
@@ -252,22 +235,22 @@ func VerifyServiceMessage(msg interface{}) error {
 		panic(fmt.Sprintf("unsupported session message %T", v))
 	}
 
-	return verifyMatryoshkaLevel(serviceMessageBody(msg), meta, verify)
+	return verifyMatryoshkaLevel(serviceMessageBody(msg), meta, verify, opts...)
 }
 
-func verifyMatryoshkaLevel(body stableMarshaler, meta metaHeader, verify verificationHeader) error {
-	if err := verifyServiceMessagePart(meta, verify.GetMetaSignature); err != nil {
+func verifyMatryoshkaLevel(body stableMarshaler, meta metaHeader, verify verificationHeader, opts ...signature.SignOption) error {
+	if err := verifyServiceMessagePart(meta, verify.GetMetaSignature, opts...); err != nil {
 		return errors.Wrap(err, "could not verify meta header")
 	}
 
 	origin := verify.getOrigin()
 
-	if err := verifyServiceMessagePart(origin, verify.GetOriginSignature); err != nil {
+	if err := verifyServiceMessagePart(origin, verify.GetOriginSignature, opts...); err != nil {
 		return errors.Wrap(err, "could not verify origin of verification header")
 	}
 
 	if origin == nil {
-		if err := verifyServiceMessagePart(body, verify.GetBodySignature); err != nil {
+		if err := verifyServiceMessagePart(body, verify.GetBodySignature, opts...); err != nil {
 			return errors.Wrap(err, "could not verify body")
 		}
 
@@ -278,13 +261,14 @@ func verifyMatryoshkaLevel(body stableMarshaler, meta metaHeader, verify verific
 		return errors.New("body signature at the matryoshka upper level")
 	}
 
-	return verifyMatryoshkaLevel(body, meta.getOrigin(), origin)
+	return verifyMatryoshkaLevel(body, meta.getOrigin(), origin, opts...)
 }
 
-func verifyServiceMessagePart(part stableMarshaler, sigRdr func() *refs.Signature) error {
+func verifyServiceMessagePart(part stableMarshaler, sigRdr func() *refs.Signature, opts ...signature.SignOption) error {
 	return signature.VerifyDataWithSource(
-		&StableMarshalerWrapper{part},
+		&StableMarshalerWrapper{SM: part},
 		keySignatureSource(sigRdr()),
+		opts...,
 	)
 }
 
@@ -2,9 +2,7 @@ package storagegroup
 
 import (
 	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
-	storagegroup "github.com/cthulhu-rider/neofs-api-go/v2/storagegroup/grpc"
 	"github.com/cthulhu-rider/neofs-api-go/v2/util/proto"
-	goproto "google.golang.org/protobuf/proto"
 )
 
 const (
@@ -73,13 +71,33 @@ func (s *StorageGroup) StableSize() (size int) {
 	return size
 }
 
-func (s *StorageGroup) Unmarshal(data []byte) error {
-	m := new(storagegroup.StorageGroup)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+func (s *StorageGroup) StableUnmarshal(data []byte) error {
+	if s == nil {
+		return nil
 	}
 
-	*s = *StorageGroupFromGRPCMessage(m)
+	*s = StorageGroup{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case sizeField:
+			s.size, err = f.UInt64()
+		case hashField:
+			s.hash = new(refs.Checksum)
+			err = f.Nested(s.hash)
+		case expirationField:
+			s.exp, err = f.UInt64()
+		case objectIDsField:
+			v := new(refs.ObjectID)
+			s.members = append(s.members, v)
+
+			err = f.Nested(v)
+		}
+
+		return
+	})
+}
 
-	return nil
+func (s *StorageGroup) Unmarshal(data []byte) error {
+	return s.StableUnmarshal(data)
 }
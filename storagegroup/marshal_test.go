@@ -19,6 +19,10 @@ func TestStorageGroup_StableMarshal(t *testing.T) {
 		require.NoError(t, storageGroupTo.Unmarshal(wire))
 
 		require.Equal(t, storageGroupFrom, storageGroupTo)
+
+		storageGroupTo = new(storagegroup.StorageGroup)
+		require.NoError(t, storageGroupTo.StableUnmarshal(wire))
+		require.Equal(t, storageGroupFrom, storageGroupTo)
 	})
 }
 
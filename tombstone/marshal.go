@@ -1,9 +1,8 @@
 package tombstone
 
 import (
-	tombstone "github.com/cthulhu-rider/neofs-api-go/v2/tombstone/grpc"
+	"github.com/cthulhu-rider/neofs-api-go/v2/refs"
 	"github.com/cthulhu-rider/neofs-api-go/v2/util/proto"
-	goproto "google.golang.org/protobuf/proto"
 )
 
 const (
@@ -70,14 +69,32 @@ func (s *Tombstone) StableSize() (size int) {
 	return size
 }
 
-// Unmarshal unmarshal tombstone message from its binary representation.
-func (s *Tombstone) Unmarshal(data []byte) error {
-	m := new(tombstone.Tombstone)
-	if err := goproto.Unmarshal(data, m); err != nil {
-		return err
+// StableUnmarshal unmarshal tombstone message from its binary representation.
+func (s *Tombstone) StableUnmarshal(data []byte) error {
+	if s == nil {
+		return nil
 	}
 
-	*s = *TombstoneFromGRPCMessage(m)
+	*s = Tombstone{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case expFNum:
+			s.exp, err = f.UInt64()
+		case splitIDFNum:
+			s.splitID, err = f.Bytes()
+		case membersFNum:
+			v := new(refs.ObjectID)
+			s.members = append(s.members, v)
+
+			err = f.Nested(v)
+		}
+
+		return
+	})
+}
 
-	return nil
+// Unmarshal unmarshal tombstone message from its binary representation.
+func (s *Tombstone) Unmarshal(data []byte) error {
+	return s.StableUnmarshal(data)
 }
@@ -19,6 +19,10 @@ func TestTombstone_StableMarshal(t *testing.T) {
 		require.NoError(t, to.Unmarshal(wire))
 
 		require.Equal(t, from, to)
+
+		to = new(tombstone.Tombstone)
+		require.NoError(t, to.StableUnmarshal(wire))
+		require.Equal(t, from, to)
 	})
 }
 
@@ -0,0 +1,292 @@
+package proto
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	stableUnmarshaller interface {
+		StableUnmarshal([]byte) error
+	}
+
+	// WireType is a type of protobuf field encoding.
+	WireType uint8
+
+	// Field is a single decoded protobuf field.
+	//
+	// Field is passed to the handler of UnmarshalFields. Value of the
+	// field should be read with the getter corresponding to the field
+	// type in protobuf schema.
+	Field struct {
+		Num int
+
+		Type WireType
+
+		val uint64
+
+		data []byte
+	}
+)
+
+const (
+	WireVarInt WireType = iota
+	WireFixed64
+	WireBytes
+	WireStartGroup
+	WireEndGroup
+	WireFixed32
+)
+
+// ErrUnexpectedEOF is returned when protobuf message ends before
+// the end of the field.
+var ErrUnexpectedEOF = errors.New("unexpected end of protobuf message")
+
+// ErrVarIntOverflow is returned when varint value does not fit into 64 bits.
+var ErrVarIntOverflow = errors.New("varint overflows 64-bit integer")
+
+// ErrInvalidFieldNumber is returned when field tag contains zero or
+// out-of-range field number.
+var ErrInvalidFieldNumber = errors.New("invalid field number")
+
+// VarUIntUnmarshal reads varint value from the beginning of buf.
+// Returns value and the number of read bytes.
+func VarUIntUnmarshal(buf []byte) (uint64, int, error) {
+	v, n := binary.Uvarint(buf)
+	switch {
+	case n == 0:
+		return 0, 0, ErrUnexpectedEOF
+	case n < 0:
+		return 0, 0, ErrVarIntOverflow
+	}
+
+	return v, n, nil
+}
+
+// TagUnmarshal reads field tag from the beginning of buf.
+// Returns field number, wire type and the number of read bytes.
+func TagUnmarshal(buf []byte) (int, WireType, int, error) {
+	tag, n, err := VarUIntUnmarshal(buf)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	fNum := tag >> 3
+	if fNum == 0 || fNum > 1<<29-1 {
+		return 0, 0, 0, ErrInvalidFieldNumber
+	}
+
+	return int(fNum), WireType(tag & 0x7), n, nil
+}
+
+// FieldUnmarshal reads single field from the beginning of buf.
+// Returns decoded field and the number of read bytes.
+//
+// Length-delimited data of the field refers to buf.
+func FieldUnmarshal(buf []byte) (Field, int, error) {
+	var (
+		f   Field
+		err error
+	)
+
+	num, typ, off, err := TagUnmarshal(buf)
+	if err != nil {
+		return f, 0, err
+	}
+
+	f.Num, f.Type = num, typ
+
+	switch typ {
+	case WireVarInt:
+		var n int
+
+		f.val, n, err = VarUIntUnmarshal(buf[off:])
+		if err != nil {
+			return f, 0, err
+		}
+
+		off += n
+	case WireFixed64:
+		if len(buf[off:]) < 8 {
+			return f, 0, ErrUnexpectedEOF
+		}
+
+		f.val = binary.LittleEndian.Uint64(buf[off:])
+		off += 8
+	case WireFixed32:
+		if len(buf[off:]) < 4 {
+			return f, 0, ErrUnexpectedEOF
+		}
+
+		f.val = uint64(binary.LittleEndian.Uint32(buf[off:]))
+		off += 4
+	case WireBytes:
+		ln, n, err := VarUIntUnmarshal(buf[off:])
+		if err != nil {
+			return f, 0, err
+		}
+
+		off += n
+
+		if ln > uint64(len(buf[off:])) {
+			return f, 0, ErrUnexpectedEOF
+		}
+
+		f.data = buf[off : off+int(ln)]
+		off += int(ln)
+	default:
+		return f, 0, errors.Errorf("unsupported wire type %d of field %d", typ, num)
+	}
+
+	return f, off, nil
+}
+
+// UnmarshalFields reads protobuf fields from data one by one and
+// passes them to h in the order of appearance.
+//
+// Handler should skip fields with unknown numbers in order to
+// stay compatible with newer message versions.
+func UnmarshalFields(data []byte, h func(Field) error) error {
+	for len(data) > 0 {
+		f, n, err := FieldUnmarshal(data)
+		if err != nil {
+			return err
+		}
+
+		if err := h(f); err != nil {
+			return errors.Wrapf(err, "could not unmarshal field %d", f.Num)
+		}
+
+		data = data[n:]
+	}
+
+	return nil
+}
+
+func (f Field) checkType(typ WireType) error {
+	if f.Type != typ {
+		return errors.Errorf("wrong wire type %d, expected %d", f.Type, typ)
+	}
+
+	return nil
+}
+
+// UInt64 returns value of uint64 field.
+func (f Field) UInt64() (uint64, error) {
+	return f.val, f.checkType(WireVarInt)
+}
+
+// Int64 returns value of int64 field.
+func (f Field) Int64() (int64, error) {
+	v, err := f.UInt64()
+	return int64(v), err
+}
+
+// UInt32 returns value of uint32 field.
+func (f Field) UInt32() (uint32, error) {
+	v, err := f.UInt64()
+	return uint32(v), err
+}
+
+// Int32 returns value of int32 field.
+func (f Field) Int32() (int32, error) {
+	v, err := f.UInt64()
+	return int32(v), err
+}
+
+// Enum returns value of enum field.
+func (f Field) Enum() (int32, error) {
+	return f.Int32()
+}
+
+// Bool returns value of bool field.
+func (f Field) Bool() (bool, error) {
+	v, err := f.UInt64()
+	return v != 0, err
+}
+
+// Fixed64 returns value of fixed64 field.
+func (f Field) Fixed64() (uint64, error) {
+	return f.val, f.checkType(WireFixed64)
+}
+
+// Bytes returns copy of bytes field value.
+func (f Field) Bytes() ([]byte, error) {
+	if err := f.checkType(WireBytes); err != nil {
+		return nil, err
+	}
+
+	v := make([]byte, len(f.data))
+	copy(v, f.data)
+
+	return v, nil
+}
+
+// StringValue returns value of string field.
+func (f Field) StringValue() (string, error) {
+	return string(f.data), f.checkType(WireBytes)
+}
+
+// Nested decodes value of embedded message field into v.
+func (f Field) Nested(v stableUnmarshaller) error {
+	if err := f.checkType(WireBytes); err != nil {
+		return err
+	}
+
+	return v.StableUnmarshal(f.data)
+}
+
+// RepeatedUInt64 appends value(s) of repeated uint64 field to v.
+//
+// Both packed and unpacked encodings are supported.
+func (f Field) RepeatedUInt64(v []uint64) ([]uint64, error) {
+	switch f.Type {
+	case WireVarInt:
+		return append(v, f.val), nil
+	case WireBytes:
+		for data := f.data; len(data) > 0; {
+			x, n, err := VarUIntUnmarshal(data)
+			if err != nil {
+				return nil, err
+			}
+
+			v = append(v, x)
+			data = data[n:]
+		}
+
+		return v, nil
+	default:
+		return nil, f.checkType(WireBytes)
+	}
+}
+
+// RepeatedInt64 appends value(s) of repeated int64 field to v.
+func (f Field) RepeatedInt64(v []int64) ([]int64, error) {
+	vs, err := f.RepeatedUInt64(nil)
+	for i := range vs {
+		v = append(v, int64(vs[i]))
+	}
+
+	return v, err
+}
+
+// RepeatedUInt32 appends value(s) of repeated uint32 field to v.
+func (f Field) RepeatedUInt32(v []uint32) ([]uint32, error) {
+	vs, err := f.RepeatedUInt64(nil)
+	for i := range vs {
+		v = append(v, uint32(vs[i]))
+	}
+
+	return v, err
+}
+
+// RepeatedInt32 appends value(s) of repeated int32 field to v.
+func (f Field) RepeatedInt32(v []int32) ([]int32, error) {
+	vs, err := f.RepeatedUInt64(nil)
+	for i := range vs {
+		v = append(v, int32(vs[i]))
+	}
+
+	return v, err
+}
@@ -0,0 +1,174 @@
+package proto_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/proto"
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/proto/test"
+	"github.com/stretchr/testify/require"
+	goproto "google.golang.org/protobuf/proto"
+)
+
+func (s *stablePrimitives) stableUnmarshal(data []byte) error {
+	*s = stablePrimitives{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case 1:
+			s.FieldA, err = f.Bytes()
+		case 2:
+			s.FieldB, err = f.StringValue()
+		case 200:
+			s.FieldC, err = f.Bool()
+		case 201:
+			s.FieldD, err = f.Int32()
+		case 202:
+			s.FieldE, err = f.UInt32()
+		case 203:
+			s.FieldF, err = f.Int64()
+		case 204:
+			s.FieldG, err = f.UInt64()
+		case 205:
+			s.FieldI, err = f.Fixed64()
+		case 300:
+			var v int32
+
+			v, err = f.Enum()
+			s.FieldH = SomeEnum(v)
+		}
+
+		return
+	})
+}
+
+func (s *stableRepPrimitives) stableUnmarshal(data []byte) error {
+	*s = stableRepPrimitives{}
+
+	return proto.UnmarshalFields(data, func(f proto.Field) (err error) {
+		switch f.Num {
+		case 1:
+			var v []byte
+
+			v, err = f.Bytes()
+			s.FieldA = append(s.FieldA, v)
+		case 2:
+			var v string
+
+			v, err = f.StringValue()
+			s.FieldB = append(s.FieldB, v)
+		case 3:
+			s.FieldC, err = f.RepeatedInt32(s.FieldC)
+		case 4:
+			s.FieldD, err = f.RepeatedUInt32(s.FieldD)
+		case 5:
+			s.FieldE, err = f.RepeatedInt64(s.FieldE)
+		case 6:
+			s.FieldF, err = f.RepeatedUInt64(s.FieldF)
+		}
+
+		return
+	})
+}
+
+func TestUnmarshalFields(t *testing.T) {
+	t.Run("primitives", func(t *testing.T) {
+		wire, err := goproto.Marshal(&test.Primitives{
+			FieldA: []byte("Hello World"),
+			FieldB: "Hello World",
+			FieldC: true,
+			FieldD: math.MinInt32,
+			FieldE: math.MaxUint32,
+			FieldF: math.MinInt64,
+			FieldG: math.MaxUint64,
+			FieldH: test.Primitives_NEGATIVE,
+			FieldI: math.MaxUint64,
+		})
+		require.NoError(t, err)
+
+		s := new(stablePrimitives)
+		require.NoError(t, s.stableUnmarshal(wire))
+		require.Equal(t, stablePrimitives{
+			FieldA: []byte("Hello World"),
+			FieldB: "Hello World",
+			FieldC: true,
+			FieldD: math.MinInt32,
+			FieldE: math.MaxUint32,
+			FieldF: math.MinInt64,
+			FieldG: math.MaxUint64,
+			FieldH: ENUM_NEGATIVE,
+			FieldI: math.MaxUint64,
+		}, *s)
+	})
+
+	t.Run("repeated primitives", func(t *testing.T) {
+		wire, err := goproto.Marshal(&test.RepPrimitives{
+			FieldA: [][]byte{[]byte("One"), []byte("Two")},
+			FieldB: []string{"One", "Two"},
+			FieldC: []int32{-1, 0, 1},
+			FieldD: []uint32{0, 1, math.MaxUint32},
+			FieldE: []int64{-1, 0, 1},
+			FieldF: []uint64{0, 1, math.MaxUint64},
+		})
+		require.NoError(t, err)
+
+		s := new(stableRepPrimitives)
+		require.NoError(t, s.stableUnmarshal(wire))
+		require.Equal(t, stableRepPrimitives{
+			FieldA: [][]byte{[]byte("One"), []byte("Two")},
+			FieldB: []string{"One", "Two"},
+			FieldC: []int32{-1, 0, 1},
+			FieldD: []uint32{0, 1, math.MaxUint32},
+			FieldE: []int64{-1, 0, 1},
+			FieldF: []uint64{0, 1, math.MaxUint64},
+		}, *s)
+	})
+
+	t.Run("stable encoding", func(t *testing.T) {
+		s := stableRepPrimitives{FieldF: []uint64{0, 1, math.MaxUint64}}
+
+		data, err := s.stableMarshal(nil, false)
+		require.NoError(t, err)
+
+		s2 := new(stableRepPrimitives)
+		require.NoError(t, s2.stableUnmarshal(data))
+		require.Equal(t, s, *s2)
+	})
+
+	t.Run("unknown fields", func(t *testing.T) {
+		s := stablePrimitives{FieldA: []byte("Hello World")}
+
+		data, err := s.stableMarshal(nil, false)
+		require.NoError(t, err)
+
+		unknown := make([]byte, proto.UInt64Size(1000, 7))
+		_, err = proto.UInt64Marshal(1000, unknown, 7)
+		require.NoError(t, err)
+
+		s2 := new(stablePrimitives)
+		require.NoError(t, s2.stableUnmarshal(append(unknown, data...)))
+		require.Equal(t, s, *s2)
+	})
+
+	t.Run("wrong wire type", func(t *testing.T) {
+		s := stablePrimitives{FieldG: 1}
+
+		data, err := s.stableMarshal(nil, false)
+		require.NoError(t, err)
+
+		require.Error(t, proto.UnmarshalFields(data, func(f proto.Field) error {
+			_, err := f.Bytes()
+			return err
+		}))
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		s := stablePrimitives{FieldA: []byte("Hello World")}
+
+		data, err := s.stableMarshal(nil, false)
+		require.NoError(t, err)
+
+		s2 := new(stablePrimitives)
+		require.Error(t, s2.stableUnmarshal(data[:len(data)-1]))
+	})
+}
@@ -17,6 +17,15 @@ type DataWithSignature interface {
 	SetSignatureWithKey(key, sig []byte)
 }
 
+type stableMarshaler interface {
+	StableMarshal([]byte) ([]byte, error)
+	StableSize() int
+}
+
+type StableMarshalerWrapper struct {
+	SM stableMarshaler
+}
+
 type SignOption func(*cfg)
 
 type KeySignatureHandler func(key []byte, sig []byte)
@@ -83,3 +92,19 @@ func SignData(key *ecdsa.PrivateKey, v DataWithSignature, opts ...SignOption) er
 func VerifyData(src DataWithSignature, opts ...SignOption) error {
 	return VerifyDataWithSource(src, src.GetSignatureWithKey, opts...)
 }
+
+func (s StableMarshalerWrapper) ReadSignedData(buf []byte) ([]byte, error) {
+	if s.SM != nil {
+		return s.SM.StableMarshal(buf)
+	}
+
+	return nil, nil
+}
+
+func (s StableMarshalerWrapper) SignedDataSize() int {
+	if s.SM != nil {
+		return s.SM.StableSize()
+	}
+
+	return 0
+}
@@ -0,0 +1,80 @@
+package tz
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// gf127 is an element of GF(2^127) field defined by
+// x^127 + x^63 + 1 polynomial.
+//
+// Element is stored as two 64-bit words, low word first.
+type gf127 [2]uint64
+
+const (
+	gf127Size = 16
+
+	msb64 = uint64(1) << 63
+)
+
+var errInvalidElement = errors.New("invalid GF(2^127) element")
+
+// add sets c to a + b.
+func (c *gf127) add(a, b *gf127) {
+	c[0] = a[0] ^ b[0]
+	c[1] = a[1] ^ b[1]
+}
+
+// mulX sets c to a * x.
+func (c *gf127) mulX(a *gf127) {
+	hi := a[1]<<1 | a[0]>>63
+	lo := a[0] << 1
+
+	// reduce x^127 by x^63 + 1
+	if hi&msb64 != 0 {
+		hi ^= msb64
+		lo ^= msb64 | 1
+	}
+
+	c[0], c[1] = lo, hi
+}
+
+// mul sets c to a * b.
+func (c *gf127) mul(a, b *gf127) {
+	var (
+		r gf127
+		d = *a
+	)
+
+	for i := 0; i < 127; i++ {
+		if b[i/64]&(1<<(uint(i)%64)) != 0 {
+			r.add(&r, &d)
+		}
+
+		d.mulX(&d)
+	}
+
+	*c = r
+}
+
+// marshal writes big-endian representation of c to buf.
+func (c *gf127) marshal(buf []byte) {
+	binary.BigEndian.PutUint64(buf, c[1])
+	binary.BigEndian.PutUint64(buf[8:], c[0])
+}
+
+// unmarshal reads big-endian representation of c from buf.
+func (c *gf127) unmarshal(buf []byte) error {
+	if len(buf) != gf127Size {
+		return errInvalidElement
+	}
+
+	c[1] = binary.BigEndian.Uint64(buf)
+	c[0] = binary.BigEndian.Uint64(buf[8:])
+
+	if c[1]&msb64 != 0 {
+		return errInvalidElement
+	}
+
+	return nil
+}
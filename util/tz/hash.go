@@ -0,0 +1,119 @@
+/*
+Package tz implements Tillich-Zemor homomorphic hash function.
+
+The hash of a message is a product of 2x2 matrices over GF(2^127)
+corresponding to the message bits. Since matrix multiplication is
+associative, the hash of concatenated messages can be calculated from
+the hashes of their parts, which allows checking the object payload by
+the hashes of its ranges.
+*/
+package tz
+
+import (
+	"hash"
+
+	"github.com/pkg/errors"
+)
+
+// Size is a length of Tillich-Zemor hash in bytes.
+const Size = sl2Size
+
+// BlockSize is a preferred size of data block for Write.
+const BlockSize = 128
+
+type digest struct {
+	x sl2
+}
+
+// New returns new hash.Hash computing Tillich-Zemor hash.
+func New() hash.Hash {
+	d := new(digest)
+	d.Reset()
+
+	return d
+}
+
+// Sum returns Tillich-Zemor hash of the data.
+func Sum(data []byte) [Size]byte {
+	var (
+		d   = digest{x: identity()}
+		sum [Size]byte
+	)
+
+	_, _ = d.Write(data)
+	d.x.marshal(sum[:])
+
+	return sum
+}
+
+func (d *digest) Write(p []byte) (int, error) {
+	for _, b := range p {
+		d.x.mulByte(b)
+	}
+
+	return len(p), nil
+}
+
+func (d *digest) Sum(b []byte) []byte {
+	var sum [Size]byte
+
+	d.x.marshal(sum[:])
+
+	return append(b, sum[:]...)
+}
+
+func (d *digest) Reset() {
+	d.x = identity()
+}
+
+func (d *digest) Size() int {
+	return Size
+}
+
+func (d *digest) BlockSize() int {
+	return BlockSize
+}
+
+// Concat returns Tillich-Zemor hash of the concatenation of the data
+// which parts have the provided hashes.
+//
+// Hashes must be listed in the order of data parts.
+func Concat(hashes [][]byte) ([]byte, error) {
+	var x, y sl2
+
+	x = identity()
+
+	for i := range hashes {
+		if err := y.unmarshal(hashes[i]); err != nil {
+			return nil, errors.Wrapf(err, "invalid hash #%d", i)
+		}
+
+		x.mul(&x, &y)
+	}
+
+	res := make([]byte, Size)
+	x.marshal(res)
+
+	return res, nil
+}
+
+// Validate checks if the hashes of consecutive data parts form
+// the hash h of the whole data.
+func Validate(h []byte, hashes [][]byte) (bool, error) {
+	var x sl2
+
+	if err := x.unmarshal(h); err != nil {
+		return false, errors.Wrap(err, "invalid hash")
+	}
+
+	sum, err := Concat(hashes)
+	if err != nil {
+		return false, err
+	}
+
+	var y sl2
+
+	_ = y.unmarshal(sum)
+
+	return x == y, nil
+}
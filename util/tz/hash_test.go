@@ -0,0 +1,127 @@
+package tz_test
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/cthulhu-rider/neofs-api-go/v2/util/tz"
+	"github.com/stretchr/testify/require"
+)
+
+func randData(t *testing.T, n int) []byte {
+	data := make([]byte, n)
+
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	return data
+}
+
+func TestHash(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		sum := tz.Sum(nil)
+
+		exp := make([]byte, tz.Size)
+		exp[15], exp[63] = 1, 1
+
+		require.Equal(t, exp, sum[:])
+	})
+
+	t.Run("streaming", func(t *testing.T) {
+		data := randData(t, 1000)
+		sum := tz.Sum(data)
+
+		h := tz.New()
+		require.Equal(t, tz.Size, h.Size())
+
+		for i := 0; i < len(data); i += 100 {
+			_, err := h.Write(data[i : i+100])
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, sum[:], h.Sum(nil))
+
+		h.Reset()
+		empty := tz.Sum(nil)
+		require.Equal(t, empty[:], h.Sum(nil))
+	})
+
+	t.Run("different data", func(t *testing.T) {
+		require.NotEqual(t, tz.Sum([]byte{0}), tz.Sum([]byte{1}))
+		require.NotEqual(t, tz.Sum([]byte{0}), tz.Sum([]byte{0, 0}))
+	})
+}
+
+// expected values are calculated with github.com/nspcc-dev/tzhash v1.4.0
+func TestHash_KnownAnswers(t *testing.T) {
+	data := make([]byte, 1000)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+
+	for _, tc := range []struct {
+		data []byte
+		sum  string
+	}{
+		{
+			data: nil,
+			sum:  "00000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001",
+		},
+		{
+			data: []byte{0},
+			sum:  "00000000000000000000000000000151000000000000000000000000000000800000000000000000000000000000008000000000000000000000000000000051",
+		},
+		{
+			data: []byte{1},
+			sum:  "00000000000000000000000000000151000000000000000000000000000001d100000000000000000000000000000080000000000000000000000000000000d1",
+		},
+		{
+			data: []byte("abc"),
+			sum:  "00000000000000000000000001cfbf620000000000000000000000000146e6f100000000000000000000000000d91897000000000000000000000000008ebe73",
+		},
+		{
+			data: []byte("The quick brown fox jumps over the lazy dog"),
+			sum:  "5575b0bbb68db7f258dadeb9a8acef153222faa78a30d868d4d0d941c0a677845afde074398ae65275d948d24446a3ef1cdaea0279d3091ad98d6a2961b9160c",
+		},
+		{
+			data: data,
+			sum:  "6aaef3367f49e9fc370677462684003b630bff85be0c364fa456b23571f30bc96d2806232489dd3fae91a9cf0c9fcf8f4b647491ae365d49f6390cf01a12c7a6",
+		},
+	} {
+		sum := tz.Sum(tc.data)
+		require.Equal(t, tc.sum, hex.EncodeToString(sum[:]), "%q", tc.data)
+	}
+}
+
+func TestConcat(t *testing.T) {
+	data := randData(t, 1000)
+	sum := tz.Sum(data)
+
+	var (
+		hashes [][]byte
+		bounds = []int{0, 1, 100, 555, 999, 1000}
+	)
+
+	for i := 1; i < len(bounds); i++ {
+		h := tz.Sum(data[bounds[i-1]:bounds[i]])
+		hashes = append(hashes, h[:])
+	}
+
+	res, err := tz.Concat(hashes)
+	require.NoError(t, err)
+	require.Equal(t, sum[:], res)
+
+	ok, err := tz.Validate(sum[:], hashes)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	hashes[0], hashes[1] = hashes[1], hashes[0]
+
+	ok, err = tz.Validate(sum[:], hashes)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = tz.Concat([][]byte{{1, 2, 3}})
+	require.Error(t, err)
+}
@@ -0,0 +1,84 @@
+package tz
+
+// sl2 is a 2x2 matrix over GF(2^127).
+type sl2 [2][2]gf127
+
+const sl2Size = 4 * gf127Size
+
+// identity returns identity matrix.
+func identity() sl2 {
+	return sl2{
+		{{1, 0}, {0, 0}},
+		{{0, 0}, {1, 0}},
+	}
+}
+
+// mulBit sets c to c * A if bit is false and c * B otherwise, where
+//
+//	A = | x 1 |   B = | x x+1 |
+//	    | 1 0 |       | 1  1  |
+func (c *sl2) mulBit(bit bool) {
+	for i := range c {
+		var t gf127
+
+		t.mulX(&c[i][0])
+		t.add(&t, &c[i][1])
+
+		if bit {
+			c[i][1].add(&t, &c[i][0])
+		} else {
+			c[i][1] = c[i][0]
+		}
+
+		c[i][0] = t
+	}
+}
+
+// mulByte multiplies c by matrices of all bits of b starting from
+// the most significant one.
+func (c *sl2) mulByte(b byte) {
+	for i := 7; i >= 0; i-- {
+		c.mulBit(b>>uint(i)&1 == 1)
+	}
+}
+
+// mul sets c to a * b.
+func (c *sl2) mul(a, b *sl2) {
+	var (
+		r    sl2
+		t, u gf127
+	)
+
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			t.mul(&a[i][0], &b[0][j])
+			u.mul(&a[i][1], &b[1][j])
+			r[i][j].add(&t, &u)
+		}
+	}
+
+	*c = r
+}
+
+// marshal writes matrix elements to buf in row-major order.
+func (c *sl2) marshal(buf []byte) {
+	c[0][0].marshal(buf)
+	c[0][1].marshal(buf[gf127Size:])
+	c[1][0].marshal(buf[2*gf127Size:])
+	c[1][1].marshal(buf[3*gf127Size:])
+}
+
+// unmarshal reads matrix elements from buf in row-major order.
+func (c *sl2) unmarshal(buf []byte) error {
+	if len(buf) != sl2Size {
+		return errInvalidElement
+	}
+
+	for i := 0; i < 4; i++ {
+		if err := c[i/2][i%2].unmarshal(buf[i*gf127Size : (i+1)*gf127Size]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}